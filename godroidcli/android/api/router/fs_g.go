@@ -15,6 +15,8 @@
 package router
 
 import (
+	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -46,6 +48,17 @@ type fsPathPair struct {
 	Dest string `json:"dest"`
 }
 
+type fsArchive struct {
+	fsPath
+	Format string `json:"format"`
+}
+
+// archiveContentTypes the content types of supported archive formats
+var archiveContentTypes = map[string]string{
+	internal.TarGz: "application/gzip",
+	internal.Zip:   "application/zip",
+}
+
 type fsPathText struct {
 	fsPath
 	Text string `json:"text"`
@@ -208,6 +221,38 @@ func (r *ApiRouter) InitFs() {
 		}
 	}
 
+	// /api/fs/archive
+	// redirect the archive stream of remote directory to client, the default format is tar.gz
+	r.mapHandlers[internal.Archive] = func(ctx *gin.Context) {
+		var value fsArchive
+		if err := ctx.ShouldBind(&value); err == nil {
+			if value.Format == "" {
+				value.Format = internal.TarGz
+			}
+			contentType, ok := archiveContentTypes[value.Format]
+			if !ok {
+				wrapper.ResponseError(ctx, status.ErrArchiveFormat)
+				return
+			}
+			ctx.Header("Content-Type", contentType)
+			ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(value.Path)+"."+value.Format))
+			err = r.Archive(value.Path, value.Format, ctx.Writer, nil)
+			if err == nil {
+				return
+			}
+			// nothing can be appended to the archive stream once it is written
+			if !ctx.Writer.Written() {
+				ctx.Writer.Header().Del("Content-Disposition")
+				ctx.Header("Content-Type", "application/json; charset=utf-8")
+				wrapper.ResponseError(ctx, err)
+			} else {
+				util.Error("archive %s: %v", value.Path, err)
+			}
+		} else {
+			wrapper.ResponseError(ctx, err)
+		}
+	}
+
 	// /api/fs/upload
 	// redirect upload file stream to Android device
 	r.mapHandlers[internal.Upload] = func(ctx *gin.Context) {
@@ -24,6 +24,7 @@ import (
 	"github.com/josexy/godroidcli/android/api/middleware"
 	"github.com/josexy/godroidcli/android/api/router"
 	"github.com/josexy/godroidcli/android/internal"
+	"github.com/josexy/godroidcli/android/vfs"
 	"github.com/josexy/godroidcli/status"
 	"github.com/josexy/godroidcli/util"
 )
@@ -57,7 +58,19 @@ func (s *Startup) initApiRouters() {
 	groups.InitAll()
 }
 
-func (s *Startup) prepare() {
+// initDavRouter share the remote directory through WebDAV under DavPrefix
+func (s *Startup) initDavRouter(root string) {
+	if root == "" {
+		root = vfs.DefaultRoot
+	}
+	handler := gin.WrapH(vfs.NewDavHandler(s.proxy, root))
+	for _, method := range vfs.DavMethods {
+		s.engine.Handle(method, vfs.DavPrefix, handler)
+		s.engine.Handle(method, vfs.DavPrefix+"/*path", handler)
+	}
+}
+
+func (s *Startup) prepare(config *util.Config) {
 
 	// quiet mode
 	gin.DefaultWriter = ioutil.Discard
@@ -66,6 +79,7 @@ func (s *Startup) prepare() {
 	s.engine = gin.New()
 	s.engine.Use(middleware.Cors(), gin.Recovery())
 	s.initApiRouters()
+	s.initDavRouter(config.DavRoot)
 }
 
 // Start start api server and accept an interrupt signal to quit
@@ -80,7 +94,7 @@ func (s *Startup) Start() (err error) {
 		return status.ErrLoadConfigFailed
 	}
 
-	s.prepare()
+	s.prepare(config)
 
 	server := http.Server{
 		Addr:    config.Address,
@@ -92,6 +106,7 @@ func (s *Startup) Start() (err error) {
 
 	util.Info("press %s to quit api server", util.Green("Ctrl+C"))
 	util.Info("listen address %s", util.Green(config.Address))
+	util.Info("webdav address %s", util.Green(config.Address+vfs.DavPrefix+"/"))
 
 	<-util.MakeInterruptChan()
 
@@ -270,6 +270,21 @@ func (adb *AdbCmd) RefreshForwardList() DeviceForwardMap {
 	return mp
 }
 
+// GetForwards return the port forwarding rules of device which match the local ports
+func (adb *AdbCmd) GetForwards(sn string, locals ...int) (forwards []Forward) {
+	mp := adb.RefreshForwardList()
+	if d, ok := mp[sn]; ok {
+		for _, forward := range d.Forwards {
+			for _, local := range locals {
+				if forward.LocalPort == local {
+					forwards = append(forwards, forward)
+				}
+			}
+		}
+	}
+	return
+}
+
 func (adb *AdbCmd) GetAllForwards() (forwards []Forward) {
 	mp := adb.RefreshForwardList()
 	for _, d := range mp {
@@ -0,0 +1,176 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/josexy/godroidcli/filter"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	aliasAssign    = "="
+	aliasSeparator = ";"
+)
+
+// initAliasList register all aliases from configuration file into CommandMap
+func (con *Console) initAliasList() {
+	for name, lines := range util.GetConfig().Aliases {
+		if _, ok := CommandMap[name]; ok {
+			util.Warn("alias [%s] conflicts with built-in command, ignored", name)
+			continue
+		}
+		con.registerAlias(name, lines)
+	}
+}
+
+func (con *Console) registerAlias(name string, lines []string) {
+	CommandMap[name] = ci{Usage: "alias: " + strings.Join(lines, "; "), Func: con.runAlias(name),
+		root: readline.PcItem(name)}
+}
+
+// runAlias expand the positional parameters and execute the command lines of alias in order,
+// the execution stops at the first failed command
+func (con *Console) runAlias(name string) func(filter.Param) {
+	return func(param filter.Param) {
+		if con.depth >= MaxNestingDepth {
+			util.Error("alias [%s] nested too deeply", name)
+			return
+		}
+		lines := filter.ExpandArgs(util.GetConfig().Aliases[name], param.Args[1:])
+		if len(lines) == 0 {
+			return
+		}
+		// the pipe is applied to the last command
+		if pipe := param.Node.PipeLine(); pipe != "" {
+			lines[len(lines)-1] += " " + pipe
+		}
+		con.depth++
+		defer func() { con.depth-- }()
+		for _, line := range lines {
+			if err := con.Execute(line); err != nil {
+				break
+			}
+		}
+	}
+}
+
+func isAlias(name string) bool {
+	_, ok := util.GetConfig().Aliases[name]
+	return ok
+}
+
+func listAliases(string) (list []string) {
+	for name := range util.GetConfig().Aliases {
+		list = append(list, name)
+	}
+	return
+}
+
+// alias define a new alias and save it into configuration file,
+// multiple commands are separated by ";"
+// > alias
+// > alias ls = cmd fs list
+// > alias pull = cmd fs cd $1 ; cmd fs download $2 ./
+// > alias apks = cmd fs list $1 | grep apk
+func (con *Console) alias(param filter.Param) {
+	if len(param.Args) == 1 {
+		con.dumpAliases(param)
+		return
+	}
+	if len(param.Args) < 4 || param.Args[2] != aliasAssign {
+		util.ErrorBy(status.ErrorIllegalOperation)
+		return
+	}
+	name := param.Args[1]
+	if _, ok := CommandMap[name]; ok && !isAlias(name) {
+		util.Error("alias [%s] conflicts with built-in command", name)
+		return
+	}
+
+	var lines []string
+	var words []string
+	flush := func() {
+		if len(words) > 0 {
+			lines = append(lines, strings.Join(words, " "))
+			words = words[:0]
+		}
+	}
+	for _, arg := range param.Args[3:] {
+		if arg == aliasSeparator {
+			flush()
+			continue
+		}
+		if strings.HasSuffix(arg, aliasSeparator) {
+			words = append(words, filter.Quote(strings.TrimSuffix(arg, aliasSeparator)))
+			flush()
+			continue
+		}
+		words = append(words, filter.Quote(arg))
+	}
+	flush()
+	if len(lines) == 0 {
+		util.ErrorBy(status.ErrProvideParams)
+		return
+	}
+	if pipe := param.Node.PipeLine(); pipe != "" {
+		lines[len(lines)-1] += " " + pipe
+	}
+
+	config := util.GetConfig()
+	if config.Aliases == nil {
+		config.Aliases = make(map[string][]string)
+	}
+	config.Aliases[name] = lines
+	con.registerAlias(name, lines)
+	con.refreshCompleter()
+	if err := util.SaveConfig(); err != nil {
+		util.ErrorBy(err)
+	}
+}
+
+// unalias remove the alias and save configuration file
+// > unalias ls
+func (con *Console) unalias(param filter.Param) {
+	defer util.RecoverIllegalOption()
+
+	name := param.Args[1]
+	if !isAlias(name) {
+		util.Error("alias not found: %s", name)
+		return
+	}
+	delete(util.GetConfig().Aliases, name)
+	delete(CommandMap, name)
+	con.refreshCompleter()
+	if err := util.SaveConfig(); err != nil {
+		util.ErrorBy(err)
+	}
+}
+
+func (con *Console) dumpAliases(param filter.Param) {
+	names := listAliases("")
+	sort.Strings(names)
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{"Name", "Commands"})
+	for _, name := range names {
+		table.AddRow(pt.Row{util.Green(name), strings.Join(util.GetConfig().Aliases[name], "; ")})
+	}
+	table.Filter(param.Node).Print()
+}
@@ -0,0 +1,118 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+const (
+	// TokenMetadataKey the pre-shared token is sent as "authorization: Bearer TOKEN"
+	TokenMetadataKey = "authorization"
+	TokenScheme      = "Bearer "
+)
+
+// lookupToken find the token of device in configuration file, the default token is used if not found
+func lookupToken(name string) string {
+	config := util.GetConfig()
+	if token, ok := config.Tokens[name]; ok {
+		return token
+	}
+	return config.Token
+}
+
+// checkTokenTransport refuse to send the token in plaintext over network, the sessions via adb port
+// forwarding stay on the local machine, the others must use TLS unless it is explicitly allowed
+func (s *Session) checkTokenTransport() error {
+	if s.opts.TLS || isLoopback(s.address) {
+		return nil
+	}
+	if !s.opts.InsecureToken && !util.GetConfig().InsecureToken {
+		return status.ErrPlaintextToken
+	}
+	util.Warn("the token is sent without TLS to %s, it can be sniffed on the network", s.address)
+	return nil
+}
+
+func isLoopback(address string) bool {
+	if address == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(address)
+	return ip != nil && ip.IsLoopback()
+}
+
+// tokenInterceptors inject the token into every unary and stream rpc call
+func tokenInterceptors(token string) []grpc.DialOption {
+	unary := func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, TokenMetadataKey, TokenScheme+token)
+		return authError(invoker(ctx, method, req, reply, cc, opts...))
+	}
+	stream := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+		method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx = metadata.AppendToOutgoingContext(ctx, TokenMetadataKey, TokenScheme+token)
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, authError(err)
+		}
+		return authClientStream{cs}, nil
+	}
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(unary),
+		grpc.WithStreamInterceptor(stream),
+	}
+}
+
+// authClientStream the error of stream is returned when receiving messages
+type authClientStream struct {
+	grpc.ClientStream
+}
+
+func (s authClientStream) RecvMsg(m interface{}) error {
+	return authError(s.ClientStream.RecvMsg(m))
+}
+
+// authError replace the unauthenticated rpc error with status.ErrAuthFailed
+func authError(err error) error {
+	if s, ok := grpcstatus.FromError(err); ok && s.Code() == codes.Unauthenticated {
+		return fmt.Errorf("%w: %s", status.ErrAuthFailed, s.Message())
+	}
+	return err
+}
+
+// verifyAuth send a ping request to check whether the server accepts the token,
+// since the connection is established without any rpc call
+func (s *Session) verifyAuth(conn *grpc.ClientConn) error {
+	ctx, cancel := context.WithTimeout(s.ctx, OpenSessionTimeout)
+	defer cancel()
+	_, err := pb.NewPingTestClient(conn).Ping(ctx, &pb.Empty{})
+	// the server without authentication never returns Unauthenticated
+	if err = authError(err); errors.Is(err, status.ErrAuthFailed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+const testToken = "secret-token"
+
+type testPingServer struct {
+	pb.UnimplementedPingTestServer
+}
+
+func (testPingServer) Ping(context.Context, *pb.Empty) (*pb.Empty, error) {
+	return &pb.Empty{}, nil
+}
+
+func checkToken(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(TokenMetadataKey); len(values) == 1 && values[0] == TokenScheme+testToken {
+		return nil
+	}
+	return grpcstatus.Error(codes.Unauthenticated, "invalid token")
+}
+
+// newTestServer start a rpc server which rejects the calls without token
+func newTestServer(t *testing.T) int {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+			if err := checkToken(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+			if err := checkToken(ss.Context()); err != nil {
+				return err
+			}
+			return handler(srv, ss)
+		}),
+	)
+	pb.RegisterPingTestServer(srv, testPingServer{})
+	pb.RegisterFsResolverServer(srv, pb.UnimplementedFsResolverServer{})
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+	return lis.Addr().(*net.TCPAddr).Port
+}
+
+func TestSession_Token(t *testing.T) {
+	port := newTestServer(t)
+	for _, token := range []string{"", "wrong-token"} {
+		_, err := NewSession(context.Background(), "127.0.0.1", "127.0.0.1", port, &AdbCmd{}, SessionOptions{Token: token})
+		if !errors.Is(err, status.ErrAuthFailed) {
+			t.Fatalf("token %q: got %v, want %v", token, err, status.ErrAuthFailed)
+		}
+		t.Log(err)
+	}
+	s, err := NewSession(context.Background(), "127.0.0.1", "127.0.0.1", port, &AdbCmd{}, SessionOptions{Token: testToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = s.CloseSession()
+}
+
+func TestTokenInterceptors_Stream(t *testing.T) {
+	port := newTestServer(t)
+	for token, want := range map[string]codes.Code{"wrong-token": codes.Unauthenticated, testToken: codes.Unimplemented} {
+		opts := append([]grpc.DialOption{grpc.WithInsecure()}, tokenInterceptors(token)...)
+		conn, err := grpc.Dial(fmt.Sprintf("127.0.0.1:%d", port), opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stream, err := pb.NewFsResolverClient(conn).DownloadGeneralFile(context.Background(), &pb.FileRange{Value: "/sdcard/1.txt"})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if want == codes.Unauthenticated && !errors.Is(err, status.ErrAuthFailed) {
+			t.Fatalf("got %v, want %v", err, status.ErrAuthFailed)
+		} else if want != codes.Unauthenticated && grpcstatus.Code(err) != want {
+			t.Fatalf("got %v, want %v", err, want)
+		}
+		t.Log(err)
+		_ = conn.Close()
+	}
+}
+
+func TestSession_CheckTokenTransport(t *testing.T) {
+	tests := []struct {
+		address string
+		opts    SessionOptions
+		want    error
+	}{
+		{"127.0.0.1", SessionOptions{Token: testToken}, nil},
+		{"192.168.1.10", SessionOptions{Token: testToken}, status.ErrPlaintextToken},
+		{"192.168.1.10", SessionOptions{Token: testToken, TLS: true}, nil},
+		{"192.168.1.10", SessionOptions{Token: testToken, InsecureToken: true}, nil},
+	}
+	for _, tt := range tests {
+		s := &Session{address: tt.address, opts: tt.opts}
+		if err := s.checkTokenTransport(); !errors.Is(err, tt.want) {
+			t.Fatalf("%s %+v: got %v, want %v", tt.address, tt.opts, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/josexy/godroidcli/filter"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	ExitSuccess = 0
+	ExitFailure = 1
+)
+
+// MaxNestingDepth limit the nesting depth of source command and aliases
+const MaxNestingDepth = 8
+
+// RunCommand execute a single command line in non-interactive mode,
+// then close the console and return the exit code
+// > godroidcli -device SERIAL:PORT -c "cmd pm all_packages user | export csv apps.csv"
+func (con *Console) RunCommand(line string) int {
+	defer con.Close()
+	if err := con.Execute(line); err != nil {
+		return ExitFailure
+	}
+	return ExitSuccess
+}
+
+// RunScript execute script file in non-interactive mode.
+// the execution will continue even though some commands failed,
+// but the exit code is non-zero if any command failed
+// > godroidcli -device SERIAL:PORT -f script.gdc
+func (con *Console) RunScript(file string) int {
+	defer con.Close()
+	if err := con.runScript(file); err != nil {
+		return ExitFailure
+	}
+	return ExitSuccess
+}
+
+func (con *Console) runScript(file string) error {
+	if con.depth >= MaxNestingDepth {
+		err := fmt.Errorf("%s: source nested too deeply", file)
+		util.ErrorBy(err)
+		return err
+	}
+	fp, err := os.Open(file)
+	if err != nil {
+		util.ErrorBy(err)
+		return err
+	}
+	defer func() { _ = fp.Close() }()
+
+	script, err := filter.ParseScript(fp)
+	if err != nil {
+		err = fmt.Errorf("%s: %s", file, err.Error())
+		util.ErrorBy(err)
+		return err
+	}
+	con.depth++
+	defer func() { con.depth-- }()
+	return script.Run(con, func(no int, line string, err error) {
+		util.Error("%s:%d: %s", file, no, line)
+	})
+}
+
+// SetVar set the value of script variable
+func (con *Console) SetVar(name, value string) {
+	con.vars[name] = value
+}
+
+// ExpandVars replace $NAME and ${NAME} with the value of script variable
+func (con *Console) ExpandVars(s string) string {
+	return filter.ExpandVars(s, con.vars)
+}
+
+// > source FILE
+func (con *Console) source(param filter.Param) {
+	defer util.RecoverIllegalOption()
+	_ = con.runScript(util.Trim(param.Args[1]))
+}
+
+// set display all variables, or set/unset a variable
+// > set
+// > set NAME VALUE
+// > set NAME			# unset variable
+func (con *Console) set(param filter.Param) {
+	switch len(param.Args) {
+	case 1:
+		var names []string
+		for name := range con.vars {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		table := pt.NewTable()
+		table.SetHeader(pt.Header{"Name", "Value"})
+		for _, name := range names {
+			table.AddRow(pt.Row{util.Green(name), con.vars[name]})
+		}
+		table.Filter(param.Node).Print()
+	case 2:
+		delete(con.vars, param.Args[1])
+	default:
+		con.SetVar(param.Args[1], strings.Join(param.Args[2:], " "))
+	}
+}
+
+// listLocalFiles list the files of current working directory
+func listLocalFiles(string) (list []string) {
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			list = append(list, entry.Name())
+		}
+	}
+	return
+}
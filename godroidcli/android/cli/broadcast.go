@@ -0,0 +1,179 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/filter"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	BroadcastPrefix    = "@"
+	BroadcastAll       = "@all"
+	BroadcastSeparator = ","
+)
+
+type broadcastResult struct {
+	name    string
+	results []resolver.Result
+}
+
+func isBroadcast(target string) bool {
+	return strings.HasPrefix(target, BroadcastPrefix)
+}
+
+// broadcastTargets parse the session names from "@all" or "@name1,@name2"
+func (con *Console) broadcastTargets(target string) (names []string) {
+	if target == BroadcastAll {
+		con.mu.Lock()
+		defer con.mu.Unlock()
+		for name := range con.sessMap {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return
+	}
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(target, BroadcastSeparator) {
+		name = strings.TrimPrefix(strings.TrimSpace(name), BroadcastPrefix)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return
+}
+
+// broadcastCommand execute the command concurrently across multiple sessions,
+// and merge the output tables of all sessions into a single table with a leading Session column
+// > cmd @all device battery
+// > cmd @emulator-5554,@192.168.1.5:9999 pm package com.android.chrome
+// > cmd @all device battery | json
+func (con *Console) broadcastCommand(param filter.Param, output string) {
+	if len(param.Args) < 3 {
+		util.ErrorBy(status.ErrProvideParams)
+		return
+	}
+	names := con.broadcastTargets(param.Args[1])
+	if len(names) == 0 {
+		util.ErrorBy(status.ErrNoSession)
+		return
+	}
+	sub := filter.Param{Node: param.Node, Args: append([]string{param.Args[0]}, param.Args[2:]...)}
+
+	// the session map is shared with the completer and the ping supervisors
+	sessions := make([]*Session, len(names))
+	con.mu.Lock()
+	for i, name := range names {
+		sessions[i] = con.sessMap[name]
+	}
+	con.mu.Unlock()
+
+	var wg sync.WaitGroup
+	results := make([]broadcastResult, len(names))
+	for i, name := range names {
+		sess := sessions[i]
+		if sess == nil {
+			util.Error("session not found: %s", name)
+			continue
+		}
+		if !sess.available() {
+			util.Warn("session [%s] is unavailable, skipped", name)
+			continue
+		}
+		wg.Add(1)
+		go func(i int, name string, sess *Session) {
+			defer wg.Done()
+			results[i] = broadcastResult{name: name, results: sess.collectCommand(sub)}
+		}(i, name, sess)
+	}
+	wg.Wait()
+
+	if format := resolver.OutputFormat(param.Node, output); format != resolver.OutputTable {
+		if data := mergeMessages(results, format); data != nil {
+			filter.PipeOutput(data, param.Node)
+		}
+	} else if table := mergeTables(results); table != nil {
+		table.Filter(param.Node).Print()
+	}
+}
+
+// mergeMessages encode the proto messages of all sessions into an object keyed by session name
+func mergeMessages(results []broadcastResult, format string) []byte {
+	merged := make(map[string]interface{})
+	for _, result := range results {
+		var objs []interface{}
+		for _, r := range result.results {
+			obj, err := resolver.MarshalOutput(r.Message)
+			if util.AssertErrorNotNil(err) {
+				continue
+			}
+			objs = append(objs, obj)
+		}
+		if len(objs) == 1 {
+			merged[result.name] = objs[0]
+		} else if len(objs) > 1 {
+			merged[result.name] = objs
+		}
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	data, err := resolver.EncodeOutput(merged, format)
+	if util.AssertErrorNotNil(err) {
+		return nil
+	}
+	return data
+}
+
+// mergeTables merge the tables of all sessions, the header of merged table is
+// taken from the first table which has a header
+func mergeTables(results []broadcastResult) *pt.PrettyTable {
+	var header pt.Header
+	var rows pt.Rows
+	for _, result := range results {
+		for _, r := range result.results {
+			table := r.Table
+			if header == nil && len(table.GetHeader()) > 0 {
+				header = append(pt.Header{"Session"}, table.GetHeader()...)
+			}
+			for _, row := range table.GetRows() {
+				rows = append(rows, append(pt.Row{util.Yellow(result.name)}, row...))
+			}
+		}
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	// key-value tables have no header
+	if header == nil {
+		header = pt.Header{"Session"}
+		for i := 1; i < len(rows[0]); i++ {
+			header = append(header, "")
+		}
+	}
+	table := pt.NewTable()
+	table.SetHeader(header)
+	table.AddRows(rows)
+	return table
+}
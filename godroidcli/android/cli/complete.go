@@ -0,0 +1,73 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/android/internal"
+)
+
+// pathCompleter is a dynamic completer which doesn't append space to directory names,
+// so that the user can continue to complete the entries in the directory
+type pathCompleter struct {
+	*readline.PrefixCompleter
+}
+
+func pcItemPath(callback readline.DynamicCompleteFunc, pc ...readline.PrefixCompleterInterface) *pathCompleter {
+	return &pathCompleter{readline.PcItemDynamic(callback, pc...)}
+}
+
+func (p *pathCompleter) GetDynamicNames(line []rune) [][]rune {
+	var names [][]rune
+	for _, name := range p.Callback(string(line)) {
+		if !strings.HasSuffix(name, "/") {
+			name += " "
+		}
+		names = append(names, []rune(name))
+	}
+	return names
+}
+
+// listRemotePaths complete the remote path arguments of fs subcommands
+// > cmd fs SUBCOMMAND ARG1 ARG2
+func (con *Console) listRemotePaths(line string) []string {
+	if con.curSess == nil || !con.curSess.available() {
+		return nil
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 3 {
+		return nil
+	}
+	var word string
+	args := fields[3:]
+	if !strings.HasSuffix(line, " ") {
+		if len(fields) == 3 {
+			return nil
+		}
+		word = fields[len(fields)-1]
+		args = args[:len(args)-1]
+	}
+	if !resolver.IsRemotePathArg(fields[2], args) {
+		return nil
+	}
+	fs, ok := con.curSess.GetResolver(internal.Fs).(*resolver.FileSystem)
+	if !ok {
+		return nil
+	}
+	return fs.CompletePath(word)
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/fatih/color"
 	"github.com/josexy/godroidcli/android/api"
 	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/android/cli/stream"
 	"github.com/josexy/godroidcli/android/internal"
 	"github.com/josexy/godroidcli/filter"
 	pt "github.com/josexy/godroidcli/prettytable"
@@ -62,6 +63,11 @@ const (
 	CliClear     = "clear"
 	CliWlan      = "wlan"
 	CliDashboard = "dashboard"
+	CliSource    = "source"
+	CliSet       = "set"
+	CliAlias     = "alias"
+	CliUnalias   = "unalias"
+	CliJobs      = "jobs"
 )
 
 const (
@@ -72,6 +78,7 @@ const (
 	Devices    = "devices"
 	Forwards   = "forwards"
 	Sessions   = "sessions"
+	Profiles   = "profiles"
 )
 
 type (
@@ -98,10 +105,14 @@ type (
 		li        *LineInfo
 		ctxP      context.Context
 		cancel    context.CancelFunc
-		wg        sync.WaitGroup
 		mu        sync.Mutex
+		gaveUp    map[string]*Session // the sessions which the ping supervisor gave up reconnecting, guarded by mu
 		adb       *AdbCmd
 		parser    *filter.CmdParser
+		vars      map[string]string // script variables
+		depth     int               // nesting depth of source command and aliases
+		output    string            // the default output format of resolver commands
+		jobs      *JobManager       // the background transfers
 		*resolver.Cmd
 	}
 )
@@ -112,6 +123,7 @@ var (
 	CmdCommandHelpInfo    []resolver.CommandHelpInfo
 	WlanCommandHelpInfo   []resolver.CommandHelpInfo
 	ListCommandHelpInfo   []resolver.CommandHelpInfo
+	JobsCommandHelpInfo   []resolver.CommandHelpInfo
 )
 
 func NewLineInfo() *LineInfo {
@@ -128,6 +140,11 @@ func NewConsole() *Console {
 		parser:  filter.NewCmdParser(),
 		Cmd:     resolver.NewCmd(),
 		sessMap: make(map[string]*Session),
+		vars:    make(map[string]string),
+		jobs:    NewJobManager(util.GetConfig().MaxJobs),
+	}
+	if size := util.GetConfig().ChunkSize; size > 0 {
+		stream.DefaultOptions.ChunkSize = size
 	}
 	rand.Seed(time.Now().UnixNano())
 	console.ctxP, console.cancel = context.WithCancel(context.Background())
@@ -159,11 +176,24 @@ func (con *Console) initCommandList() {
 		root: readline.PcItem(CliClear)}
 	CommandMap[CliDashboard] = ci{Usage: "start api server", Func: con.dashboard,
 		root: readline.PcItem(CliDashboard)}
+	CommandMap[CliSource] = ci{Usage: "execute commands from script file", Func: con.source,
+		root: readline.PcItem(CliSource, readline.PcItemDynamic(listLocalFiles))}
+	CommandMap[CliSet] = ci{Usage: "set or display script variables", Func: con.set,
+		root: readline.PcItem(CliSet)}
+	CommandMap[CliAlias] = ci{Usage: "define or display command aliases", Func: con.alias,
+		root: readline.PcItem(CliAlias)}
+	CommandMap[CliUnalias] = ci{Usage: "remove command alias", Func: con.unalias,
+		root: readline.PcItem(CliUnalias, readline.PcItemDynamic(listAliases))}
+	CommandMap[CliJobs] = ci{Usage: "list, cancel or wait for background transfers", Func: con.jobsCommand,
+		root: readline.PcItem(CliJobs,
+			readline.PcItem(JobCancel),
+			readline.PcItem(JobWait))}
 	CommandMap[CliList] = ci{Usage: "list active devices", Func: con.list,
 		root: readline.PcItem(CliList,
 			readline.PcItem(Devices),
 			readline.PcItem(Forwards),
-			readline.PcItem(Sessions))}
+			readline.PcItem(Sessions),
+			readline.PcItem(Profiles))}
 	CommandMap[CliWlan] = ci{Usage: "use WLAN(TCP/IP) instead of USB", Func: con.wlan,
 		root: readline.PcItem(CliWlan,
 			readline.PcItem(Listen,
@@ -176,7 +206,7 @@ func (con *Console) initCommandList() {
 				readline.PcItemDynamic(con.adb.getAllDeviceSerialNumber)))}
 	CommandMap[CliConnect] = ci{Usage: "connect to device and create a connection session", Func: con.connect,
 		root: readline.PcItem(CliConnect,
-			readline.PcItemDynamic(con.adb.getAllDeviceSerialNumber))}
+			readline.PcItemDynamic(con.listConnectTargets))}
 	CommandMap[CliKill] = ci{Usage: "close current connection session", Func: con.kill,
 		root: readline.PcItem(CliKill,
 			readline.PcItemDynamic(con.listSessions))}
@@ -192,7 +222,8 @@ func (con *Console) initCommandList() {
 	CommandMap[CliCmd] = ci{Usage: "execute session commands", Func: con.resolverCommand,
 		root: readline.PcItem(CliCmd,
 			readline.PcItem(internal.Pm, readline.PcItemDynamic(GetSubCommand)),
-			readline.PcItem(internal.Fs, readline.PcItemDynamic(GetSubCommand)),
+			readline.PcItem(internal.Fs, readline.PcItemDynamic(GetSubCommand,
+				pcItemPath(con.listRemotePaths, pcItemPath(con.listRemotePaths)))),
 			readline.PcItem(internal.Di, readline.PcItemDynamic(GetSubCommand)),
 			readline.PcItem(internal.Net, readline.PcItemDynamic(GetSubCommand)),
 			readline.PcItem(internal.Ctrl, readline.PcItemDynamic(GetSubCommand)),
@@ -236,10 +267,16 @@ func (con *Console) initCommandHelpInfo() {
 	WlanCommandHelpInfo[3] = resolver.CommandHelpInfo{Name: Stop, Usage: "disconnect the device and stop listening on the port"}
 
 	// list
-	ListCommandHelpInfo = make([]resolver.CommandHelpInfo, 3)
+	ListCommandHelpInfo = make([]resolver.CommandHelpInfo, 4)
 	ListCommandHelpInfo[0] = resolver.CommandHelpInfo{Name: Devices, Usage: "display all found devices"}
 	ListCommandHelpInfo[1] = resolver.CommandHelpInfo{Name: Forwards, Usage: "display all forwards for devices"}
 	ListCommandHelpInfo[2] = resolver.CommandHelpInfo{Name: Sessions, Usage: "display all connected sessions for devices"}
+	ListCommandHelpInfo[3] = resolver.CommandHelpInfo{Name: Profiles, Usage: "display all saved device profiles"}
+
+	// jobs
+	JobsCommandHelpInfo = make([]resolver.CommandHelpInfo, 2)
+	JobsCommandHelpInfo[0] = resolver.CommandHelpInfo{Name: JobCancel, Usage: "cancel the queued or running transfers by id"}
+	JobsCommandHelpInfo[1] = resolver.CommandHelpInfo{Name: JobWait, Usage: "wait for all or the specified transfers to finish"}
 
 	// all resolvers help information
 	CmdSubCommandHelpInfo = make(map[string][]resolver.CommandHelpInfo)
@@ -258,14 +295,23 @@ func (con *Console) initCommandHelpInfo() {
 func (con *Console) init() {
 	con.initCommandList()
 	con.initCommandHelpInfo()
+	con.initAliasList()
 
 	con.completer = readline.NewPrefixCompleter()
+	con.refreshCompleter()
+}
+
+// refreshCompleter rebuild the completer items from CommandMap
+func (con *Console) refreshCompleter() {
 	var items []readline.PrefixCompleterInterface
 	for _, v := range CommandMap {
 		items = append(items, v.root)
 	}
 	con.completer.SetChildren(items)
+}
 
+// initReadline create the readline instance used by the interactive shell
+func (con *Console) initReadline() {
 	var err error
 	con.instance, err = readline.NewEx(&readline.Config{
 		Prompt:          con.prompt(),
@@ -285,8 +331,6 @@ func (con *Console) init() {
 	if err != nil {
 		panic(err)
 	}
-	con.checkAliveSessions()
-	con.startup()
 }
 
 func (con *Console) prompt() string {
@@ -294,52 +338,78 @@ func (con *Console) prompt() string {
 }
 
 func (con *Console) updatePrompt() {
-	con.instance.SetPrompt(con.prompt())
+	// there is no prompt in non-interactive mode
+	if con.instance != nil {
+		con.instance.SetPrompt(con.prompt())
+	}
 }
 
+// Run enter the interactive shell
 func (con *Console) Run() {
+	con.initReadline()
+	con.startup()
 	for {
 		line, err := con.instance.Readline()
 		// ignore Ctrl+C and Ctrl+D
 		if err == readline.ErrInterrupt || err == io.EOF {
 			continue
 		}
-		con.li.Line = strings.TrimSpace(line)
+		_ = con.Execute(line)
+	}
+}
 
-		if err = con.parser.Parse(con.li.Line); err != nil {
-			if err != status.ErrEmptyString {
-				util.ErrorBy(err)
-			}
-			continue
+// Execute parse a command line and dispatch it through CommandMap.
+// a non-nil error is returned if the line could not be parsed,
+// the command was not found or any error was reported while executing
+func (con *Console) Execute(line string) (err error) {
+	con.killGaveUpSessions()
+	con.li.Line = strings.TrimSpace(line)
+
+	if err = con.parser.Parse(con.li.Line); err != nil {
+		if err == status.ErrEmptyString {
+			return nil
 		}
-		parts := con.parser.Root().Group()
-		if len(parts) == 0 {
-			continue
+		util.ErrorBy(err)
+		return err
+	}
+	// expand the variables per argument, so that their values are never parsed as syntax
+	con.parser.Root().ExpandVars(con.vars)
+	parts := con.parser.Root().Group()
+	if len(parts) == 0 {
+		return nil
+	}
+
+	count := util.ErrorCount()
+	defer func() {
+		if err == nil && util.ErrorCount() != count {
+			err = status.ErrCommandFailed
 		}
-		// execute local command
-		if strings.HasPrefix(parts[0], "!") {
-			// at least 1 argument
-			first := parts[0]
-			if len(first) == 1 && len(parts) >= 2 {
-				// !, ls, -l
-				parts = parts[1:]
+	}()
+
+	// execute local command
+	if strings.HasPrefix(parts[0], "!") {
+		// at least 1 argument
+		first := parts[0]
+		if len(first) == 1 && len(parts) >= 2 {
+			// !, ls, -l
+			parts = parts[1:]
+			con.executeLocalSimpleCmd(filter.Param{Node: con.parser.Root().Right, Args: parts})
+		} else if len(parts) >= 1 {
+			// !ls, -l
+			parts[0] = first[1:]
+			if len(parts[0]) > 0 {
 				con.executeLocalSimpleCmd(filter.Param{Node: con.parser.Root().Right, Args: parts})
-			} else if len(parts) >= 1 {
-				// !ls, -l
-				parts[0] = first[1:]
-				if len(parts[0]) > 0 {
-					con.executeLocalSimpleCmd(filter.Param{Node: con.parser.Root().Right, Args: parts})
-				}
 			}
-			continue
-		}
-		if command, ok := CommandMap[parts[0]]; ok {
-			command.Func(filter.Param{Node: con.parser.Root().Right, Args: parts})
-			con.updatePrompt()
-		} else {
-			con.notFoundCommand()
 		}
+		return
+	}
+	if command, ok := CommandMap[parts[0]]; ok {
+		command.Func(filter.Param{Node: con.parser.Root().Right, Args: parts})
+		con.updatePrompt()
+	} else {
+		con.notFoundCommand()
 	}
+	return
 }
 
 // listSessions list of all current connection sessions
@@ -356,49 +426,22 @@ func (con *Console) listSessions(string) (list []string) {
 	return
 }
 
-// checkAliveSessions start a goroutine for checking current alive sessions,
-// and delete those dead sessions
-// notice: this will ignore those sessions connected via ip address
-func (con *Console) checkAliveSessions() {
-	go func() {
-		for {
-			select {
-			case <-con.ctxP.Done():
-				// notify main goroutine to exit program safely
-				con.wg.Done()
-				return
-			default:
-			}
-
-			con.mu.Lock()
-			if con.sessMap != nil {
-				mp := con.adb.RefreshDeviceList()
-				for sn, session := range con.sessMap {
-					var flag bool
-					for _, i := range mp {
-						if i.SerialNumber == sn {
-							flag = true
-							break
-						}
-					}
-					if !flag {
-						// ignore
-						if session.address != "127.0.0.1" {
-							flag = true
-						}
-					}
-					if !flag {
-						// delete dead session
-						con.kill(filter.Param{Args: []string{CliKill, sn}})
-					}
-				}
-			}
-			con.mu.Unlock()
-
-			// delay
-			time.Sleep(time.Second)
+// killGaveUpSessions kill the sessions which the ping supervisor gave up reconnecting,
+// it is called by the main goroutine since the current session may be reset
+func (con *Console) killGaveUpSessions() {
+	var keys []string
+	con.mu.Lock()
+	for key, sess := range con.gaveUp {
+		// the session may have been killed or replaced
+		if con.sessMap[key] == sess {
+			keys = append(keys, key)
 		}
-	}()
+	}
+	con.gaveUp = nil
+	con.mu.Unlock()
+	for _, key := range keys {
+		con.kill(filter.Param{Args: []string{CliKill, key}})
+	}
 }
 
 func (con *Console) notFoundCommand() {
@@ -462,6 +505,8 @@ func (con *Console) help(param filter.Param) {
 			display(ListCommandHelpInfo)
 		case CliWlan:
 			display(WlanCommandHelpInfo)
+		case CliJobs:
+			display(JobsCommandHelpInfo)
 		}
 	}
 	table.Filter(param.Node).Print()
@@ -504,17 +549,21 @@ func (con *Console) clearForwardRules() {
 
 // > exit quit program gracefully
 func (con *Console) exit(filter.Param) {
-	_ = con.instance.Close()
-	con.wg.Add(1)
+	con.Close()
+	util.Info("Bye! Have fun! :)")
+	os.Exit(0)
+}
+
+// Close kill all sessions, cancel the child goroutines
+// and clear the forwarding rules
+func (con *Console) Close() {
+	if con.instance != nil {
+		_ = con.instance.Close()
+	}
 	con.gracefulExit()
-	// wait for all child goroutines to exit gracefully
-	con.wg.Wait()
 
 	// clear forward rules
 	con.clearForwardRules()
-
-	util.Info("Bye! Have fun! :)")
-	os.Exit(0)
 }
 
 // > version display current program version information
@@ -540,7 +589,7 @@ func (con *Console) clear(filter.Param) {
 
 // > dashboard start web server
 func (con *Console) dashboard(filter.Param) {
-	if con.curSess == nil || con.curSess.status == unavailable {
+	if con.curSess == nil || !con.curSess.available() {
 		util.Warn("current session not found or unavailable and can not start web server")
 		return
 	}
@@ -628,6 +677,7 @@ func (con *Console) wlan(param filter.Param) {
 // > list devices
 // > list forwards
 // > list sessions
+// > list profiles
 func (con *Console) list(param filter.Param) {
 	defer util.RecoverIllegalOption()
 
@@ -665,13 +715,15 @@ func (con *Console) list(param filter.Param) {
 		table.SetHeader(pt.Header{"Name", "Connection", "Status"})
 		for name, session := range con.sessMap {
 			var status string
-			if session.status == alive {
+			if session.available() {
 				status = util.Green("alive")
 			} else {
 				status = util.Red("unavailable")
 			}
 			table.AddRow(pt.Row{name, session.conn.Target(), status})
 		}
+	case Profiles:
+		con.fillProfileTable(table)
 	default:
 		table = nil
 		con.notFoundCommand()
@@ -693,46 +745,78 @@ func (con *Console) unsetSession(sn string) {
 
 // connect try to connect to server and open a new session
 // > connect SERIAL PORT
+// > connect IP PORT --tls
+// > connect IP PORT --token TOKEN
+// > connect @PROFILE
 func (con *Console) connect(param filter.Param) {
 	defer util.RecoverIllegalOption()
-	snOrIp := param.Args[1]
-	if port, err := strconv.Atoi(param.Args[2]); err != nil {
+	args, opts := parseSessionOptions(param.Args)
+	snOrIp := args[1]
+	if strings.HasPrefix(snOrIp, ProfilePrefix) {
+		if err := con.NewSessionByProfile(strings.TrimPrefix(snOrIp, ProfilePrefix), opts); err != nil {
+			util.ErrorBy(err)
+		}
+	} else if port, err := strconv.Atoi(args[2]); err != nil {
 		util.ErrorBy(err)
 	} else if sess, ok := con.sessMap[snOrIp]; ok {
 		util.Warn("session [%s] has already exist", sess)
 	} else if _, ok = con.adb.CheckPortIsExist(snOrIp, port); !ok {
 		// try to create to a new session by ip address and port
 		util.Warn("not match forward rule %s:%d", snOrIp, port)
-		if err = con.newSession("", snOrIp, port); err != nil {
+		if err = con.newSession("", snOrIp, port, opts); err != nil {
 			util.ErrorBy(err)
 		}
 	} else {
 		// create a new session by serial number and port
-		if err = con.newSession(snOrIp, "", port); err != nil {
+		if err = con.newSession(snOrIp, "", port, opts); err != nil {
 			util.ErrorBy(err)
 		}
 	}
 }
 
-func (con *Console) newSession(sn, address string, port int) error {
+func (con *Console) newSession(sn, address string, port int, opts SessionOptions) error {
 	if sn != "" {
 		address = "127.0.0.1"
 	} else if address != "" {
 		sn = address
 	}
 	util.Info("try to connect to android server...")
-	sess, err := NewSession(con.ctxP, sn, address, port, con.adb)
+	if conf := util.GetConfig().TLS; conf != nil && conf.Enable {
+		opts.TLS = true
+	}
+	if opts.Token == "" {
+		if sn != address {
+			opts.Token = lookupToken(sn)
+		} else {
+			opts.Token = lookupToken(fmt.Sprintf("%s:%d", address, port))
+		}
+	}
+	sess, err := NewSession(con.ctxP, sn, address, port, con.adb, opts)
 	if err != nil {
 		return err
 	}
 
 	// create session by serial number
+	key := sn
 	if sn != address {
-		con.sessMap[sn] = sess
+		// the port forwarding rules are restored when reconnecting
+		sess.forwards = con.adb.GetForwards(sn, port, port+1)
 	} else {
 		// create session by ip address and port
-		con.sessMap[fmt.Sprintf("%s:%d", address, port)] = sess
+		key = fmt.Sprintf("%s:%d", address, port)
 	}
+	con.mu.Lock()
+	con.sessMap[key] = sess
+	con.mu.Unlock()
+	sess.setOnGiveUp(func() {
+		// killed later by the main goroutine, which owns the current session
+		con.mu.Lock()
+		defer con.mu.Unlock()
+		if con.gaveUp == nil {
+			con.gaveUp = make(map[string]*Session)
+		}
+		con.gaveUp[key] = sess
+	})
 	con.resetSession(sess)
 	return nil
 }
@@ -744,9 +828,9 @@ func (con *Console) randomGenLocalPort() int {
 }
 
 // NewSessionBy create a connection and open session from command
-// NewSessionBy("emulator-5554", 9999, false)
-// NewSessionBy("192.168.1.161", 9999, true)
-func (con *Console) NewSessionBy(name string, port int, device_address bool) error {
+// NewSessionBy("emulator-5554", 9999, true, SessionOptions{})
+// NewSessionBy("192.168.1.161", 9999, false, SessionOptions{TLS: true})
+func (con *Console) NewSessionBy(name string, port int, device_address bool, opts SessionOptions) error {
 	var err error
 	// device
 	if device_address {
@@ -759,22 +843,25 @@ func (con *Console) NewSessionBy(name string, port int, device_address bool) err
 		if err = con.newDeviceForward(name, local+1, port+1); err != nil {
 			return err
 		}
-		err = con.newSession(name, "", local)
+		err = con.newSession(name, "", local, opts)
 	} else {
 		// TCP/IP
-		err = con.newSession("", name, port)
+		err = con.newSession("", name, port, opts)
 	}
 	return err
 }
 
-// kill close the session, notice this method may be called by goroutine used for checking
+// kill close the session
 // > kill SERIAL
 func (con *Console) kill(param filter.Param) {
 	defer util.RecoverIllegalOption()
 
 	sn := param.Args[1]
-	if sess, ok := con.sessMap[sn]; ok {
-		delete(con.sessMap, sn)
+	con.mu.Lock()
+	sess, ok := con.sessMap[sn]
+	delete(con.sessMap, sn)
+	con.mu.Unlock()
+	if ok {
 		if err := sess.CloseSession(); err == nil {
 			util.Info("kill session [%s]", sess)
 			// reset current session is nil
@@ -872,11 +959,20 @@ func (con *Console) newDeviceForward(sn string, local, remote int) error {
 }
 
 func (con *Console) resolverCommand(param filter.Param) {
-	if con.curSess == nil {
+	var output string
+	var ok bool
+	if param.Args, output, ok = con.parseOutputOption(param.Args); !ok {
+		return
+	}
+	if len(param.Args) > 1 && param.Args[len(param.Args)-1] == JobBackground {
+		con.backgroundCommand(filter.Param{Node: param.Node, Args: param.Args[:len(param.Args)-1]})
+	} else if len(param.Args) > 1 && isBroadcast(param.Args[1]) {
+		con.broadcastCommand(param, output)
+	} else if con.curSess == nil {
 		util.ErrorBy(status.ErrNoSession)
-	} else if con.curSess.status == unavailable {
+	} else if !con.curSess.available() {
 		util.ErrorBy(status.ErrSessionUnavailable)
 	} else {
-		con.curSess.executeCommand(param)
+		con.curSess.executeCommand(param, output)
 	}
 }
@@ -0,0 +1,363 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/android/cli/stream"
+	"github.com/josexy/godroidcli/android/internal"
+	"github.com/josexy/godroidcli/filter"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/progressbar"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// JobBackground run the transfer command in background if it ends with "&"
+	JobBackground = "&"
+	JobCancel     = "cancel"
+	JobWait       = "wait"
+	// DefaultMaxJobs the number of background transfers running concurrently by default
+	DefaultMaxJobs = 2
+)
+
+const (
+	JobQueued   = "queued"
+	JobRunning  = "running"
+	JobDone     = "done"
+	JobFailed   = "failed"
+	JobCanceled = "canceled"
+)
+
+// transferCommands the resolver commands which can be run in background
+var transferCommands = map[string][]string{
+	internal.Fs: {internal.Upload, internal.Download, internal.Sync, internal.Archive},
+	internal.Pm: {internal.GetApk, internal.GetIcon},
+	internal.Ms: {internal.Download, internal.Thumbnail},
+}
+
+// JobFunc run the transfer and report the progress to fn until ctx is canceled
+type JobFunc func(ctx context.Context, fn stream.ProgressCallback) error
+
+// Job the transfer running in background
+type Job struct {
+	ID      int
+	Session string
+	Command string
+	state   string
+	err     error
+	meter   *progressbar.Meter
+	// the elapsed time is frozen when the job is finished
+	elapsed string
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// JobManager queue the background transfers and run them with limited concurrency
+type JobManager struct {
+	mu     sync.Mutex
+	jobs   []*Job
+	nextID int
+	slots  chan struct{}
+}
+
+func NewJobManager(concurrency int) *JobManager {
+	if concurrency <= 0 {
+		concurrency = DefaultMaxJobs
+	}
+	return &JobManager{slots: make(chan struct{}, concurrency)}
+}
+
+// Submit queue the job, it starts running as soon as there is a free slot
+func (m *JobManager) Submit(ctx context.Context, session, command string, fn JobFunc) *Job {
+	ctx, cancel := context.WithCancel(ctx)
+	job := &Job{
+		Session: session,
+		Command: command,
+		state:   JobQueued,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	m.mu.Lock()
+	m.nextID++
+	job.ID = m.nextID
+	m.jobs = append(m.jobs, job)
+	m.mu.Unlock()
+
+	go func() {
+		defer close(job.done)
+		defer cancel()
+		select {
+		case m.slots <- struct{}{}:
+			defer func() { <-m.slots }()
+		case <-ctx.Done():
+			m.finish(job, ctx.Err())
+			return
+		}
+		// the meter starts when the job is running rather than queued
+		meter := progressbar.NewMeter()
+		m.mu.Lock()
+		job.state, job.meter = JobRunning, meter
+		m.mu.Unlock()
+		err := fn(ctx, meter.Update)
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		m.finish(job, err)
+	}()
+	return job
+}
+
+func (m *JobManager) finish(job *Job, err error) {
+	state := JobDone
+	switch {
+	case errors.Is(err, context.Canceled):
+		state, err = JobCanceled, nil
+	case err != nil:
+		state = JobFailed
+	}
+	m.mu.Lock()
+	job.state, job.err = state, err
+	if job.meter != nil {
+		job.elapsed = job.meter.Elapsed()
+	}
+	m.mu.Unlock()
+	util.Info("[%d] %s: %s", job.ID, state, job.Command)
+}
+
+// snapshot the current state, error and meter of job, the meter is nil if the job is queued
+func (m *JobManager) snapshot(job *Job) (state string, err error, meter *progressbar.Meter, elapsed string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return job.state, job.err, job.meter, job.elapsed
+}
+
+// Jobs list all jobs in the order of submission
+func (m *JobManager) Jobs() []*Job {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*Job(nil), m.jobs...)
+}
+
+func (m *JobManager) find(id int) (*Job, error) {
+	for _, job := range m.Jobs() {
+		if job.ID == id {
+			return job, nil
+		}
+	}
+	return nil, fmt.Errorf("job not found: %d", id)
+}
+
+// Cancel stop the queued or running job
+func (m *JobManager) Cancel(id int) error {
+	job, err := m.find(id)
+	if err != nil {
+		return err
+	}
+	select {
+	case <-job.done:
+		return fmt.Errorf("job has finished: %d", id)
+	default:
+	}
+	job.cancel()
+	<-job.done
+	return nil
+}
+
+// Wait block until all the jobs are finished, false is returned if ctx is done before that
+func (m *JobManager) Wait(ctx context.Context, jobs ...*Job) bool {
+	for _, job := range jobs {
+		select {
+		case <-job.done:
+		case <-ctx.Done():
+			return false
+		}
+	}
+	return true
+}
+
+// newJobResolver create a standalone resolver sharing the connection of session,
+// so that the background job does not interfere with the commands in foreground
+func (s *Session) newJobResolver(ctx context.Context, name string) *resolver.ResolverContext {
+	var r resolver.Resolver
+	switch name {
+	case internal.Fs:
+		r = resolver.NewFileSystem(s.conn)
+	case internal.Pm:
+		r = resolver.NewPackageManager(s.conn)
+	case internal.Ms:
+		r = resolver.NewMediaStore(s.conn)
+	default:
+		return nil
+	}
+	// keep the current remote directory
+	if sr, ok := r.(resolver.StatefulResolver); ok {
+		if old := s.GetResolver(name); old != nil {
+			sr.CopyState(old)
+		}
+	}
+	rc := resolver.NewResolverContext(ctx, r, s, s.adb)
+	r.SetContext(rc)
+	return rc
+}
+
+func isTransferCommand(args []string) bool {
+	if len(args) < 3 {
+		return false
+	}
+	for _, sub := range transferCommands[args[1]] {
+		if args[2] == sub {
+			return true
+		}
+	}
+	return false
+}
+
+// backgroundCommand run the transfer command in background and return immediately
+// > cmd fs download /sdcard/DCIM/1.mp4 ./ &
+func (con *Console) backgroundCommand(param filter.Param) {
+	if !isTransferCommand(param.Args) {
+		util.Error("only the transfer commands can be run in background")
+		return
+	}
+	sess := con.curSess
+	if sess == nil {
+		util.ErrorBy(status.ErrNoSession)
+		return
+	}
+	if !sess.available() {
+		util.ErrorBy(status.ErrSessionUnavailable)
+		return
+	}
+	name := param.Args[1]
+	sub := filter.Param{Node: param.Node, Args: param.Args[2:]}
+	job := con.jobs.Submit(sess.ctx, sess.sn, strings.Join(param.Args, " "),
+		func(ctx context.Context, fn stream.ProgressCallback) (err error) {
+			defer func() {
+				if e := recover(); e != nil {
+					err = status.ErrorIllegalOperation
+				}
+			}()
+			rc := sess.newJobResolver(ctx, name)
+			rc.SetProgress(fn)
+			// the errors of job are reported by its state rather than failing the command in foreground
+			util.Uncounted(func() { rc.DoProcess(sub) })
+			return rc.Error
+		})
+	util.Info("[%d] %s", job.ID, job.Command)
+}
+
+// > jobs
+// > jobs cancel 1
+// > jobs wait
+// > jobs wait 1
+func (con *Console) jobsCommand(param filter.Param) {
+	if len(param.Args) == 1 {
+		con.dumpJobs(param)
+		return
+	}
+	var ids []int
+	for _, arg := range param.Args[2:] {
+		id, err := util.StrToInt(arg)
+		if err != nil {
+			util.Error("invalid job id: %s", arg)
+			return
+		}
+		ids = append(ids, id)
+	}
+	switch param.Args[1] {
+	case JobCancel:
+		if len(ids) == 0 {
+			util.ErrorBy(status.ErrProvideParams)
+			return
+		}
+		for _, id := range ids {
+			util.AssertErrorNotNil(con.jobs.Cancel(id))
+		}
+	case JobWait:
+		jobs := con.jobs.Jobs()
+		if len(ids) > 0 {
+			jobs = jobs[:0]
+			for _, id := range ids {
+				job, err := con.jobs.find(id)
+				if util.AssertErrorNotNil(err) {
+					return
+				}
+				jobs = append(jobs, job)
+			}
+		}
+		// stop waiting rather than quitting the program when Ctrl+C is pressed
+		ctx, stop := signal.NotifyContext(con.ctxP, os.Interrupt)
+		defer stop()
+		if !con.jobs.Wait(ctx, jobs...) {
+			util.Warn("stop waiting, the jobs are still running in background")
+		}
+	default:
+		util.ErrorBy(status.ErrorIllegalOperation)
+	}
+}
+
+func (con *Console) dumpJobs(param filter.Param) {
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{
+		util.Green("ID"),
+		"Session",
+		"Command",
+		util.Yellow("State"),
+		util.Blue("Transferred"),
+		"Progress",
+		util.Red("Rate"),
+		"Elapsed",
+		"ETA",
+		"Error",
+	})
+	for _, job := range con.jobs.Jobs() {
+		state, err, meter, elapsed := con.jobs.snapshot(job)
+		if meter == nil {
+			meter = progressbar.NewMeter()
+			elapsed = "-"
+		}
+		present, total := meter.Progress()
+		rate, eta, msg := "-", "-", ""
+		if state == JobRunning {
+			rate, eta, elapsed = meter.Rate(), meter.ETA(), meter.Elapsed()
+		}
+		if err != nil {
+			msg = err.Error()
+		}
+		table.AddRow(pt.Row{
+			util.Green(util.IntToStr(job.ID)),
+			job.Session,
+			job.Command,
+			util.Yellow(state),
+			util.Blue(util.CalcFileBytes(present) + "/" + util.CalcFileBytes(total)),
+			meter.Percent(),
+			util.Red(rate),
+			elapsed,
+			eta,
+			msg,
+		})
+	}
+	table.Filter(param.Node).Print()
+}
@@ -0,0 +1,93 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/josexy/godroidcli/android/cli/stream"
+)
+
+func TestJobManager(t *testing.T) {
+	m := NewJobManager(2)
+	var running, maxRunning int32
+	release := make(chan struct{})
+	transfer := func(ctx context.Context, fn stream.ProgressCallback) error {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			old := atomic.LoadInt32(&maxRunning)
+			if n <= old || atomic.CompareAndSwapInt32(&maxRunning, old, n) {
+				break
+			}
+		}
+		fn(50, 100)
+		select {
+		case <-release:
+			fn(100, 100)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	failed := m.Submit(context.Background(), "test", "cmd fs upload", func(context.Context, stream.ProgressCallback) error {
+		return errors.New("broken pipe")
+	})
+	m.Wait(context.Background(), failed)
+
+	var jobs []*Job
+	for i := 0; i < 3; i++ {
+		jobs = append(jobs, m.Submit(context.Background(), "test", "cmd fs download", transfer))
+	}
+	// one of the jobs is queued until a running job is finished
+	time.Sleep(50 * time.Millisecond)
+	states := make(map[string][]*Job)
+	for _, job := range jobs {
+		state, _, _, _ := m.snapshot(job)
+		states[state] = append(states[state], job)
+	}
+	if len(states[JobRunning]) != 2 || len(states[JobQueued]) != 1 {
+		t.Fatalf("got %d running and %d queued jobs, want 2 and 1", len(states[JobRunning]), len(states[JobQueued]))
+	}
+	canceled := states[JobRunning][0]
+	if err := m.Cancel(canceled.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Cancel(canceled.ID); err == nil {
+		t.Fatal("the finished job is canceled again")
+	}
+	close(release)
+	if !m.Wait(context.Background(), m.Jobs()...) {
+		t.Fatal("the jobs are not finished")
+	}
+
+	want := map[*Job]string{failed: JobFailed, canceled: JobCanceled, states[JobRunning][1]: JobDone, states[JobQueued][0]: JobDone}
+	for job, state := range want {
+		if got, _, _, _ := m.snapshot(job); got != state {
+			t.Fatalf("job %d: got state %s, want %s", job.ID, got, state)
+		}
+	}
+	if _, _, meter, _ := m.snapshot(states[JobQueued][0]); meter.Percent() != "100%" {
+		t.Fatalf("got progress %s, want 100%%", meter.Percent())
+	}
+	if maxRunning != 2 {
+		t.Fatalf("got %d jobs running concurrently, want 2", maxRunning)
+	}
+}
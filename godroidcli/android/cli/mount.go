@@ -0,0 +1,48 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"github.com/josexy/godroidcli/android/vfs"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+// MountCommand mount the device storage as a local file system
+const MountCommand = "mount"
+
+// Mount mount the remote directory of current session at the mountpoint,
+// then close the console and return the exit code after unmounted
+// > godroidcli -device SERIAL:PORT mount /mnt/phone [/sdcard]
+func (con *Console) Mount(args []string) int {
+	defer con.Close()
+	if len(args) == 0 {
+		util.ErrorBy(status.ErrProvideParams)
+		return ExitFailure
+	}
+	if con.curSess == nil || !con.curSess.available() {
+		util.ErrorBy(status.ErrNoSession)
+		return ExitFailure
+	}
+	root := vfs.DefaultRoot
+	if len(args) > 1 {
+		root = args[1]
+	}
+	if err := vfs.Mount(con.curSess.CreateSessionProxy(), args[0], root); err != nil {
+		util.ErrorBy(err)
+		return ExitFailure
+	}
+	return ExitSuccess
+}
@@ -0,0 +1,54 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/util"
+)
+
+const OptionOutput = "--output"
+
+// SetOutput set the default output format of resolver commands
+// > godroidcli -output json
+func (con *Console) SetOutput(format string) error {
+	if !resolver.IsOutputFormat(format) {
+		return fmt.Errorf("unsupported output format: %s", format)
+	}
+	con.output = format
+	return nil
+}
+
+// parseOutputOption remove the output option from arguments, the default output format
+// is returned if the option is not given
+// > cmd device battery --output json
+func (con *Console) parseOutputOption(args []string) (rest []string, output string, ok bool) {
+	output = con.output
+	for i := 0; i < len(args); i++ {
+		if args[i] != OptionOutput {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) || !resolver.IsOutputFormat(args[i+1]) {
+			util.Error("usage: %s table|json|yaml", OptionOutput)
+			return nil, "", false
+		}
+		i++
+		output = args[i]
+	}
+	return rest, output, true
+}
@@ -55,13 +55,17 @@ func (p *pingContext) ping() {
 			default:
 			}
 			// ping rpc server
+			status := alive
 			_, err := p.client.Ping(p.ctx, &pb.Empty{})
 			if err != nil {
 				// rpc server is unavailable
-				p.statusChan <- unavailable
-			} else {
-				// rpc server is alive
-				p.statusChan <- alive
+				status = unavailable
+			}
+			select {
+			case p.statusChan <- status:
+			case <-p.ctx.Done():
+				// the ping context may be cancelled while reconnecting
+				return
 			}
 			// delay
 			time.Sleep(DelayTime)
@@ -0,0 +1,92 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/android/internal"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/util"
+)
+
+const ProfilePrefix = "@"
+
+// NewSessionByProfile open a session with the saved profile, the given options take precedence over the profile
+// > godroidcli -profile lab-pixel
+// > connect @lab-pixel
+// > connect @lab-pixel --tls --token TOKEN
+func (con *Console) NewSessionByProfile(name string, opts SessionOptions) error {
+	profile, ok := util.GetConfig().Profiles[name]
+	if !ok {
+		return fmt.Errorf("profile not found: %s", name)
+	}
+	opts.TLS = opts.TLS || profile.TLS
+	opts.InsecureToken = opts.InsecureToken || profile.InsecureToken
+	if opts.Token == "" {
+		opts.Token = profile.Token
+	}
+	var err error
+	if profile.Serial != "" {
+		err = con.NewSessionBy(profile.Serial, profile.Port, true, opts)
+	} else if profile.Address != "" {
+		err = con.NewSessionBy(profile.Address, profile.Port, false, opts)
+	} else {
+		err = fmt.Errorf("profile [%s] has no serial or address", name)
+	}
+	if err != nil {
+		return err
+	}
+	if profile.RemoteDir != "" {
+		con.curSess.GetResolver(internal.Fs).(*resolver.FileSystem).SetRemoteDir(profile.RemoteDir)
+	}
+	return nil
+}
+
+func listProfiles() (list []string) {
+	for name := range util.GetConfig().Profiles {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return
+}
+
+// listConnectTargets list the serial numbers of devices and the names of profiles
+func (con *Console) listConnectTargets(s string) (list []string) {
+	list = con.adb.getAllDeviceSerialNumber(s)
+	for _, name := range listProfiles() {
+		list = append(list, ProfilePrefix+name)
+	}
+	return
+}
+
+func (con *Console) fillProfileTable(table *pt.PrettyTable) {
+	table.SetHeader(pt.Header{"Name", "Target", "Port", "TLS", "Token", "RemoteDir"})
+	for _, name := range listProfiles() {
+		profile := util.GetConfig().Profiles[name]
+		target := profile.Serial
+		if target == "" {
+			target = profile.Address
+		}
+		token := ""
+		if profile.Token != "" {
+			token = "******"
+		}
+		table.AddRow(pt.Row{util.Green(name), target, util.IntToStr(profile.Port),
+			util.BoolToStr(profile.TLS), token, profile.RemoteDir})
+	}
+}
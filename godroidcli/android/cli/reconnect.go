@@ -0,0 +1,137 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/josexy/godroidcli/android/cli/resolver"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	ReconnectMinDelay    = time.Second
+	ReconnectMaxDelay    = time.Second * 30
+	MaxReconnectAttempts = 10
+)
+
+// setOnGiveUp set the callback which is called when the supervisor gives up reconnecting
+func (s *Session) setOnGiveUp(fn func()) {
+	s.mu.Lock()
+	s.onGiveUp = fn
+	s.mu.Unlock()
+}
+
+func (s *Session) isReconnecting() bool {
+	return atomic.LoadInt32(&s.reconnecting) == 1
+}
+
+// reconnect re-establish the connection with exponential backoff, until it succeeds,
+// the session is closed or the attempts are exhausted
+func (s *Session) reconnect() {
+	atomic.StoreInt32(&s.reconnecting, 1)
+	defer atomic.StoreInt32(&s.reconnecting, 0)
+
+	// stop pinging the broken connection
+	s.pc.cancel()
+	// the session is closed
+	if s.ctx.Err() != nil {
+		return
+	}
+	util.Warn("session [%s] is unavailable, try to reconnect...", s)
+
+	delay := ReconnectMinDelay
+	for attempt := 1; attempt <= MaxReconnectAttempts; attempt++ {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		err := s.reconnectOnce()
+		if err == nil {
+			util.Info("session [%s] reconnected", s)
+			return
+		}
+		util.Warn("reconnect session [%s] failed (%d/%d): %s", s, attempt, MaxReconnectAttempts, err.Error())
+		if delay *= 2; delay > ReconnectMaxDelay {
+			delay = ReconnectMaxDelay
+		}
+	}
+	util.Error("give up reconnecting session [%s]", s)
+	s.mu.RLock()
+	onGiveUp := s.onGiveUp
+	s.mu.RUnlock()
+	if onGiveUp != nil {
+		onGiveUp()
+	}
+}
+
+// reconnectOnce restore the port forwarding rules, redial the rpc server and re-create
+// all resolvers, the state of old resolvers is kept
+func (s *Session) reconnectOnce() error {
+	if err := s.restoreForwards(); err != nil {
+		return err
+	}
+	conn, err := s.dial(OpenSessionTimeout)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	old := s.resolvers
+	_ = s.conn.Close()
+	s.conn = conn
+	s.initResolvers()
+	for name, ctx := range s.resolvers {
+		r, ok := ctx.Resolver.(resolver.StatefulResolver)
+		if oldCtx, found := old[name]; ok && found {
+			r.CopyState(oldCtx.Resolver)
+		}
+	}
+	s.mu.Unlock()
+
+	// the api server holds the session proxy
+	if s.proxy != nil {
+		s.bindProxy()
+	}
+	s.pc = NewPingContext(s.conn, s.ctx)
+	s.pc.ping()
+	s.setStatus(alive)
+	return nil
+}
+
+// restoreForwards add the port forwarding rules of session again,
+// since they are lost after the device is re-plugged
+func (s *Session) restoreForwards() error {
+	if len(s.forwards) == 0 {
+		return nil
+	}
+	mp, ok := s.adb.CheckDeviceIsExist(s.sn)
+	if !ok {
+		return status.ErrNotFoundOrNotExisted
+	}
+	if df := mp[s.sn]; df.Status != "device" {
+		return fmt.Errorf("device %s %s", s.sn, df.Status)
+	}
+	for _, forward := range s.forwards {
+		if _, ok = s.adb.CheckPortIsExist(s.sn, forward.LocalPort); !ok {
+			s.adb.AddForward(s.sn, forward.LocalPort, forward.RemotePort)
+		}
+	}
+	return nil
+}
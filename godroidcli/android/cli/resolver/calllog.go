@@ -75,7 +75,7 @@ func (c *CallLog) dumpAllCallLogInfo() {
 				util.Yellow(util.TimeOf(info.Date)),
 			})
 	}
-	table.Filter(c.Param.Node).Print()
+	c.PrintResult(list, table)
 }
 
 func (c *CallLog) dumpCallLogInfo(number string) {
@@ -108,7 +108,7 @@ func (c *CallLog) dumpCallLogInfo(number string) {
 			typ,
 		})
 	}
-	table.Filter(c.Param.Node).Print()
+	c.PrintResult(cl, table)
 }
 
 func (c *CallLog) dumpDeleteCallLog(number string) {
@@ -119,7 +119,7 @@ func (c *Contact) dumpAllContactInfo() {
 			util.Red(email),
 		})
 	}
-	table.Filter(c.Param.Node).Print()
+	c.PrintResult(list, table)
 }
 
 func (c *Contact) dumpContactInfo(id string) {
@@ -156,7 +156,7 @@ func (c *Contact) dumpContactInfo(id string) {
 		}
 	}
 
-	table.Filter(c.Param.Node).Print()
+	c.PrintResult(ci, table)
 }
 
 func (c *Contact) dumpDeleteContact(id string) {
@@ -115,7 +115,7 @@ func (d *Device) dumpDeviceInfo() {
 	fn("Bluetooth", util.BoolToStr(di.Bluetooth))
 	fn("Location", util.BoolToStr(di.Location))
 	fn("BuildTime", util.TimeOf(di.BuildTime))
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(di, table)
 }
 
 func (d *Device) dumpSystemInfo() {
@@ -141,7 +141,7 @@ func (d *Device) dumpSystemInfo() {
 	fn("MCC", util.Int32ToStr(si.Mcc))
 	fn("MNC", util.Int32ToStr(si.Mnc))
 
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(si, table)
 }
 
 func (d *Device) dumpBatteryInfo() {
@@ -164,7 +164,7 @@ func (d *Device) dumpBatteryInfo() {
 	fn("Technology", bi.Technology)
 	fn("Temperature", fmt.Sprintf("%.1f°C", float32(bi.Temperature)/10.0))
 	fn("Voltage", fmt.Sprintf("%dmV", bi.Voltage))
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(bi, table)
 }
 
 func (d *Device) dumpDisplayInfo() {
@@ -190,7 +190,7 @@ func (d *Device) dumpDisplayInfo() {
 	fn("ScreenOffTime", util.Int32ToStr(di.ScreenOffTime/1000)+"s")
 	fn("ScreenBrightness", util.Int32ToStr(di.ScreenBrightness))
 	fn("ScreenBrightnessMode", di.ScreenBrightnessMode)
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(di, table)
 }
 
 func (d *Device) dumpMemoryInfo() {
@@ -214,7 +214,7 @@ func (d *Device) dumpMemoryInfo() {
 		util.Red(util.BoolToStr(mi.LowMemory)),
 		util.CalcCommonBytes(mi.Threshold),
 	})
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(mi, table)
 }
 
 func (d *Device) dumpStorageInfo() {
@@ -235,7 +235,7 @@ func (d *Device) dumpStorageInfo() {
 		util.Yellow(util.CalcCommonBytes(ssi.UsedSize)),
 		util.Red(util.CalcCommonBytes(ssi.TotalSize)),
 	})
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(ssi, table)
 }
 
 func (d *Device) dumpLocationInfo() {
@@ -257,7 +257,7 @@ func (d *Device) dumpLocationInfo() {
 	fn("Locality", li.Locality)
 	fn("SubLocality", li.SubLocality)
 	fn("AddressLine", li.AddressLine)
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(li, table)
 }
 
 func (d *Device) dumpCPUFrequency() {
@@ -274,7 +274,7 @@ func (d *Device) dumpCPUFrequency() {
 			util.Int32ToStr(f/1000) + "MHz",
 		})
 	}
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(list, table)
 }
 
 func (d *Device) dumpGPUInfo() {
@@ -290,7 +290,7 @@ func (d *Device) dumpGPUInfo() {
 	fn("Renderer", gi.Renderer)
 	fn("Vendor", gi.Vendor)
 	fn("Version", gi.Version)
-	table.Filter(d.Param.Node).Print()
+	d.PrintResult(gi, table)
 }
 
 // Run
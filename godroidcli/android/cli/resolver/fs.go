@@ -15,13 +15,15 @@
 package resolver
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
-	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/josexy/godroidcli/android/cli/stream"
 	"github.com/josexy/godroidcli/android/internal"
@@ -31,6 +33,8 @@ import (
 	pb "github.com/josexy/godroidcli/protobuf"
 	"github.com/josexy/godroidcli/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 var FsHelpList = []CommandHelpInfo{
@@ -38,8 +42,8 @@ var FsHelpList = []CommandHelpInfo{
 	{internal.RmDir, "remove all directories and files recursively"},
 	{internal.Delete, "delete a file"},
 	{internal.Create, "create an empty file"},
-	{internal.Download, "pull a file from Android device"},
-	{internal.Upload, "push a file to Android device"},
+	{internal.Download, "pull a file or directory (-r) from Android device"},
+	{internal.Upload, "push a file or directory (-r) to Android device"},
 	{internal.ForceDownload, "force pull a file from Android device via adb command"},
 	{internal.ForceUpload, "force push a file to Android device via adb command"},
 	{internal.List, "list the contents of directory"},
@@ -51,6 +55,18 @@ var FsHelpList = []CommandHelpInfo{
 	{internal.AppendText, "append text to existing file"},
 	{internal.WriteText, "truncate and write new text to file"},
 	{internal.ReadText, "read the entire contents of an existing file"},
+	{internal.Cat, "print the contents of file as they are received"},
+	{internal.Head, "print the first lines of file (-n N)"},
+	{internal.Tail, "print the last lines of file (-n N), and follow the appended lines (-f)"},
+	{internal.Edit, "edit a file with $EDITOR and upload it if changed"},
+	{internal.Hash, "calculate the sha256 or md5 digest of a file"},
+	{internal.Sync, "sync a local directory to Android device, or reverse (--pull)"},
+	{internal.Find, "search for files by name, size, modification time and type"},
+	{internal.Du, "show the total size of directory and its sub directories (--depth N)"},
+	{internal.Top, "list the largest files under directory (-n N)"},
+	{internal.Trash, "turn safe mode on or off, list, restore ID or empty the paths deleted in safe mode"},
+	{internal.Undo, "revert the last rename or move"},
+	{internal.Archive, "download a directory as a tar.gz or zip archive stream"},
 }
 
 type InternalDirType int
@@ -60,6 +76,16 @@ const (
 	ApkFile
 )
 
+const (
+	// PartFileSuffix the suffix of partial file which is being downloaded
+	PartFileSuffix = ".part"
+	// PartMetaSuffix the suffix of file which saves the size and modification time of remote file,
+	// the partial file is resumed only if the remote file is not changed
+	PartMetaSuffix = ".part.meta"
+	// MaxResumeAttempts the max number of times to resume an interrupted download
+	MaxResumeAttempts = 3
+)
+
 const (
 	Image    = "image"
 	Video    = "video"
@@ -71,11 +97,18 @@ type FileSystem struct {
 	*ResolverContext
 	resolver  pb.FsResolverClient
 	remoteDir string
+	// the cache of remote directories for path completion
+	dirCache map[string]dirCacheEntry
+	cacheMu  sync.Mutex
+	// the deleted paths in safe mode and the renames or moves of the last command
+	trash     *trashBin
+	lastMoves []movePair
 }
 
 func NewFileSystem(conn *grpc.ClientConn) *FileSystem {
 	fs := &FileSystem{
 		resolver: pb.NewFsResolverClient(conn),
+		trash:    newTrashBin(false, ""),
 	}
 	return fs
 }
@@ -84,6 +117,20 @@ func (f *FileSystem) SetContext(ctx *ResolverContext) {
 	f.ResolverContext = ctx
 }
 
+// SetRemoteDir change the current remote directory
+func (f *FileSystem) SetRemoteDir(dir string) {
+	f.remoteDir = f.concat(dir)
+}
+
+// CopyState keep the current remote directory, the trash and the last moves after reconnection
+func (f *FileSystem) CopyState(r Resolver) {
+	if old, ok := r.(*FileSystem); ok {
+		f.remoteDir = old.remoteDir
+		f.trash = old.trash
+		f.lastMoves = old.lastMoves
+	}
+}
+
 func (f *FileSystem) concat(spath string) string {
 	if spath == "" {
 		return spath
@@ -96,37 +143,141 @@ func (f *FileSystem) concat(spath string) string {
 
 // DownloadFile download file from Android device and redirect the bytes stream to io.Writer
 func (f *FileSystem) DownloadFile(src string, writer io.Writer, fn stream.ProgressCallback) error {
-	s, err := f.resolver.DownloadGeneralFile(f.ctx, &pb.String{Value: src})
-	return stream.HandleDownloadStream(s, err, writer, fn)
+	return f.DownloadFileRange(src, 0, 0, writer, fn)
 }
 
-// DownloadGeneralFile download file from Android device and write to file
-func (f *FileSystem) DownloadGeneralFile(src, dest string) error {
-	src = f.concat(src)
+// DownloadFileRange download length bytes of file from the offset and redirect the bytes stream to io.Writer,
+// the length less than or equal to zero means until the end of file
+func (f *FileSystem) DownloadFileRange(src string, offset, length int64, writer io.Writer, fn stream.ProgressCallback) error {
+	s, err := f.resolver.DownloadGeneralFile(f.ctx, &pb.FileRange{Value: src, Offset: offset, Length: length})
+	return stream.HandleDownloadStreamFrom(s, err, offset, writer, fn)
+}
+
+// isResumable check whether the interrupted download can be resumed
+func isResumable(err error) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	switch grpcstatus.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal:
+		return true
+	}
+	return false
+}
+
+// downloadDest the local file path which the remote file is downloaded to
+func downloadDest(src, dest string) string {
 	baseSrc := filepath.Base(src)
 	if dest == "" {
+		return baseSrc
+	}
+	// save the file into the directory
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
 		dest = filepath.Join(dest, baseSrc)
-	} else {
-		var info fs.FileInfo
-		info, f.Error = os.Stat(dest)
-		// delete previous file
-		if f.Error == nil && info.IsDir() {
-			dest = filepath.Join(dest, baseSrc)
+	}
+	return dest
+}
+
+// partMeta the remote file which the partial file belongs to
+type partMeta struct {
+	Size  int64 `json:"size"`
+	Mtime int64 `json:"mtime"`
+}
+
+// openPartFile open the partial file for appending, it is truncated unless it belongs to
+// the current remote file, which is checked by the size and modification time.
+// The remote file is stat only if the partial file exists and info is nil
+func (f *FileSystem) openPartFile(src, dest string, info *pb.FileInfo) (*os.File, error) {
+	part, metaFile := dest+PartFileSuffix, dest+PartMetaSuffix
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if pi, err := os.Stat(part); err == nil && pi.Size() > 0 {
+		if info == nil {
+			info, _ = f.statFile(src)
+		}
+		var saved partMeta
+		data, err := os.ReadFile(metaFile)
+		if info == nil || err != nil || json.Unmarshal(data, &saved) != nil ||
+			saved != (partMeta{Size: info.Size, Mtime: info.LastModifiedTime}) || pi.Size() > info.Size {
+			util.Warn("the partial file does not match the remote file, download from the beginning: %s", part)
+			flag |= os.O_TRUNC
 		}
+	} else {
+		flag |= os.O_TRUNC
 	}
-	var fp *os.File
-	fp, f.Error = os.Create(dest)
-	if f.Error != nil {
-		return f.Error
+	return os.OpenFile(part, flag, 0644)
+}
+
+// keepPartFile save the remote file which the partial file belongs to, so that the download can be resumed later.
+// the partial file is removed if the remote file can not be stat or its size is not the total size downloading
+func (f *FileSystem) keepPartFile(src, dest string, info *pb.FileInfo, total int64) bool {
+	part, metaFile := dest+PartFileSuffix, dest+PartMetaSuffix
+	if info == nil {
+		info, _ = f.statFile(src)
+	}
+	if info != nil && (total < 0 || info.Size == total) {
+		data, _ := json.Marshal(&partMeta{Size: info.Size, Mtime: info.LastModifiedTime})
+		if os.WriteFile(metaFile, data, 0644) == nil {
+			return true
+		}
 	}
+	_ = os.Remove(part)
+	_ = os.Remove(metaFile)
+	return false
+}
 
+// downloadPartFile download the remote file to dest through the partial file, the interrupted download
+// is retried from the end of partial file, and the partial file is kept for resuming if it still fails
+func (f *FileSystem) downloadPartFile(src, dest string, info *pb.FileInfo, fn stream.ProgressCallback) error {
+	part := dest + PartFileSuffix
+	fp, err := f.openPartFile(src, dest, info)
+	if err != nil {
+		return err
+	}
 	defer func() { _ = fp.Close() }()
 
+	total := int64(-1)
+	progress := func(present, size int64) {
+		total = size
+		if fn != nil {
+			fn(present, size)
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		var offset, written int64
+		if offset, err = fp.Seek(0, io.SeekEnd); err != nil {
+			return err
+		}
+		if attempt == 0 && offset > 0 {
+			util.Info("resume download from: %s", util.CalcFileBytes(offset))
+		}
+		if err = f.DownloadFileRange(src, offset, 0, fp, progress); err == nil {
+			break
+		}
+		// retry only if some bytes have been received
+		written, _ = fp.Seek(0, io.SeekEnd)
+		if !isResumable(err) || written == offset || attempt >= MaxResumeAttempts {
+			_ = fp.Close()
+			if written > 0 && f.keepPartFile(src, dest, info, total) {
+				util.Warn("the partial file is kept, download again to resume: %s", part)
+			}
+			return err
+		}
+	}
+	if err = fp.Close(); err != nil {
+		return err
+	}
+	_ = os.Remove(dest + PartMetaSuffix)
+	return os.Rename(part, dest)
+}
+
+// DownloadGeneralFile download file from Android device and write to file
+// the bytes stream is appended to the partial file first, and the download will be resumed
+// from the end of partial file if it exists and the remote file is not changed, finally the partial file is renamed to dest
+func (f *FileSystem) DownloadGeneralFile(src, dest string) error {
+	src = f.concat(src)
+	dest = downloadDest(src, dest)
 	// show download progressbar
-	pb := progressbar.New(dest)
-	f.Error = f.DownloadFile(src, fp, func(present, total int64) {
-		pb.Update(present, total)
-	})
+	f.Error = f.downloadPartFile(src, dest, nil, f.Progress(progressbar.New(dest)))
 	return f.Error
 }
 
@@ -136,21 +287,35 @@ func (f *FileSystem) UploadFile(reader io.Reader, dest string) error {
 	return stream.HandleUploadStream(s, err, []byte(dest), reader)
 }
 
-// UploadGeneralFile upload file to Android device
-func (f *FileSystem) UploadGeneralFile(src, dest string) error {
+// uploadDest the remote file path which the local file is uploaded to
+func (f *FileSystem) uploadDest(src, dest string) string {
 	// concat dir + file
 	if dest == "" || strings.HasSuffix(dest, ".") || strings.HasSuffix(dest, "/") {
 		dest = filepath.Join(dest, filepath.Base(src))
 	}
-	dest = f.concat(dest)
+	return f.concat(dest)
+}
+
+// UploadGeneralFile upload file to Android device
+func (f *FileSystem) UploadGeneralFile(src, dest string) error {
+	dest = f.uploadDest(src, dest)
 	// read local file
 	reader, err := os.Open(src)
 	if err != nil {
 		return err
 	}
-	err = f.UploadFile(reader, dest)
-	reader.Close()
-	return err
+	defer func() { _ = reader.Close() }()
+	info, err := reader.Stat()
+	if err != nil {
+		return err
+	}
+	// show upload progressbar
+	var present int64
+	progress := f.Progress(progressbar.New(filepath.Base(src)).HideSavePath())
+	return f.UploadFile(&countReader{reader: reader, fn: func(n int64) {
+		present += n
+		progress(present, info.Size())
+	}}, dest)
 }
 
 func (f *FileSystem) GetBaseFileTree(path, id, mode string) (*pb.String, error) {
@@ -218,21 +383,25 @@ func (f *FileSystem) doOperand(op string, args ...string) {
 	}
 	switch op {
 	case internal.Create:
-		f.CreateFile(first)
-	case internal.Delete:
-		f.DeleteFile(first)
+		f.Error = f.CreateFile(first)
+	case internal.Delete,
+		internal.RmDir:
+		f.Error = f.remove(op, first)
 	case internal.MkDir:
-		f.MkDir(first)
-	case internal.RmDir:
-		f.RmDir(first)
+		f.Error = f.MkDir(first)
 	case internal.Move:
-		f.Move(first, second)
+		if f.Error = f.Move(first, second); f.Error == nil {
+			f.recordMoves([]movePair{{op, first, second}})
+		}
 	case internal.Rename:
-		f.Rename(first, second)
+		if f.Error = f.Rename(first, second); f.Error == nil {
+			f.recordMoves([]movePair{{op, first, second}})
+		}
 	case internal.Copy:
-		f.Copy(first, second)
+		f.Error = f.Copy(first, second)
 	default:
 	}
+	f.invalidateDirCache()
 	util.AssertErrorNotNil(f.Error)
 }
 
@@ -267,18 +436,52 @@ func (f *FileSystem) dumpListFiles(dir string) {
 			util.BoolToStr(fi.Executable),
 		})
 	}
-	table.Filter(f.Param.Node).Print()
+	f.PrintResult(list, table)
 }
 
-func (f *FileSystem) dumpUploadOrDownload(s1, s2, op string) {
+// transferFile upload or download a file, and compare the digests of local file and remote file if verify
+func (f *FileSystem) transferFile(op, src, dest string, verify bool) (err error) {
+	var local, remote string
 	switch op {
 	case internal.Upload:
-		f.Error = f.UploadGeneralFile(s1, s2)
-		util.AssertErrorNotNil(f.Error)
+		local, remote = src, f.uploadDest(src, dest)
+		err = f.UploadGeneralFile(src, dest)
 	case internal.Download:
-		f.Error = f.DownloadGeneralFile(s1, s2)
-		util.AssertErrorNotNil(f.Error)
+		remote = f.concat(src)
+		local = downloadDest(remote, dest)
+		err = f.DownloadGeneralFile(src, dest)
+	}
+	if err != nil || !verify {
+		return
 	}
+	return f.verifyFile(local, remote)
+}
+
+func (f *FileSystem) dumpUploadOrDownload(s1, s2, op string, verify bool) {
+	f.Error = f.transferFile(op, s1, s2, verify)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	if verify {
+		util.Info("verify successfully: %s", util.HiGreen(s1))
+	}
+}
+
+// splitOptions separate the options from the arguments
+func splitOptions(args []string, options ...string) ([]string, map[string]bool) {
+	var rest []string
+	set := make(map[string]bool)
+next:
+	for _, arg := range args {
+		for _, option := range options {
+			if util.Trim(arg) == option {
+				set[option] = true
+				continue next
+			}
+		}
+		rest = append(rest, arg)
+	}
+	return rest, set
 }
 
 func (f *FileSystem) dumpForceUploadOrDownload(s1, s2, op string) {
@@ -321,6 +524,17 @@ func (f *FileSystem) dumpReadText(src string) {
 // Run
 // > cmd fs upload ./app-debug.apk /data/local/tmp/1.apk
 // > cmd fs download /data/local/tmp/tmp.apk ./1.apk
+// > cmd fs upload -r ./images /storage/emulated/0/Pictures/
+// > cmd fs download -r /storage/emulated/0/DCIM ./
+// > cmd fs download --verify /data/local/tmp/tmp.apk ./1.apk
+// > cmd fs hash /data/local/tmp/tmp.apk md5
+// > cmd fs download /storage/emulated/0/DCIM/**/*.jpg ./
+// > cmd fs delete logs/*.txt --yes
+// > cmd fs sync --delete --dry-run ./fixtures /storage/emulated/0/fixtures
+// > cmd fs sync --pull /storage/emulated/0/fixtures ./fixtures
+// > cmd fs find /storage/emulated/0 -name '*.log' -size +10M -mtime -2 -type f
+// > cmd fs du /storage/emulated/0 --depth 2
+// > cmd fs top /storage/emulated/0 -n 20
 // > cmd fs list /storage/emulated/0/Download
 // > cmd fs create /storage/emulated/0/Download/1.txt
 // > cmd fs delete /storage/emulated/0/Download/1.txt
@@ -332,6 +546,11 @@ func (f *FileSystem) dumpReadText(src string) {
 // > cmd fs pwd
 // > cmd fs write /storage/emulated/0/Download/tmp "this is a text"
 // > cmd fs read /storage/emulated/0/Download/tmp
+// > cmd fs cat /storage/emulated/0/Download/app.log | grep ERROR
+// > cmd fs head /storage/emulated/0/Download/app.log -n 20
+// > cmd fs tail -f /storage/emulated/0/Download/app.log | grep ERROR
+// > cmd fs edit /storage/emulated/0/Download/config.json
+// > cmd fs archive /storage/emulated/0/Android/data/com.example.app/files app_files.tar.gz
 // > cmd fs move /storage/emulated/0/Download/tmp /data/local/tmp
 // > cmd fs copy /storage/emulated/0/Download/tmp /data/local/tmp
 // > cmd fs rename /storage/emulated/0/Download/tmp /data/local/tmp
@@ -340,7 +559,16 @@ func (f *FileSystem) Run(param filter.Param) bool {
 	switch param.Args[0] {
 	case internal.Upload,
 		internal.Download:
-		f.dumpUploadOrDownload(util.Trim(param.Args[1]), util.Trim(param.Args[2]), param.Args[0])
+		args, options := splitOptions(param.Args[1:], OptionRecursive, OptionVerify)
+		if hasGlob(args[0]) {
+			f.dumpGlobTransfer(param.Args[0], args[0], util.Trim(args[1]), options)
+			break
+		}
+		if options[OptionRecursive] {
+			f.dumpRecursiveUploadOrDownload(util.Trim(args[0]), util.Trim(args[1]), param.Args[0], options[OptionVerify])
+			break
+		}
+		f.dumpUploadOrDownload(util.Trim(args[0]), util.Trim(args[1]), param.Args[0], options[OptionVerify])
 	case internal.List:
 		f.dumpListFiles(util.Trim(param.Args[1]))
 	case internal.Create,
@@ -350,6 +578,10 @@ func (f *FileSystem) Run(param filter.Param) bool {
 		internal.Copy,
 		internal.Rename,
 		internal.Move:
+		if args, options := splitOptions(param.Args[1:], OptionYes); globOperands[param.Args[0]] && hasGlob(args[0]) {
+			f.dumpGlobOperand(param.Args[0], args, options[OptionYes])
+			break
+		}
 		f.doOperand(param.Args[0], param.Args[1:]...)
 	case internal.ForceUpload,
 		internal.ForceDownload:
@@ -370,6 +602,33 @@ func (f *FileSystem) Run(param filter.Param) bool {
 		f.dumpWriteText(param.Args[0], util.Trim(param.Args[1]), util.Trim(param.Args[2]))
 	case internal.ReadText:
 		f.dumpReadText(util.Trim(param.Args[1]))
+	case internal.Cat,
+		internal.Head,
+		internal.Tail:
+		f.dumpStreamFile(param.Args[0], param.Args[1:])
+	case internal.Edit:
+		f.dumpEditFile(util.Trim(param.Args[1]))
+	case internal.Sync:
+		args, options := splitOptions(param.Args[1:], OptionPull, OptionDelete, OptionDryRun, OptionHash)
+		f.dumpSync(args, options)
+	case internal.Hash:
+		algorithm := HashSHA256
+		if len(param.Args) > 2 {
+			algorithm = util.Trim(param.Args[2])
+		}
+		f.dumpFileHash(util.Trim(param.Args[1]), algorithm)
+	case internal.Find:
+		f.dumpFind(util.Trim(param.Args[1]), param.Args[2:])
+	case internal.Du:
+		f.dumpDiskUsage(param.Args[1:])
+	case internal.Top:
+		f.dumpTopFiles(param.Args[1:])
+	case internal.Trash:
+		f.dumpTrash(param.Args[1:])
+	case internal.Undo:
+		f.dumpUndo()
+	case internal.Archive:
+		f.dumpArchive(param.Args[1:])
 	default:
 		return false
 	}
@@ -0,0 +1,291 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/josexy/godroidcli/android/cli/stream"
+	"github.com/josexy/godroidcli/android/internal"
+	"github.com/josexy/godroidcli/progressbar"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+// archiveWriter write the entries of remote directory to an archive stream
+type archiveWriter interface {
+	// Add write the header of entry and return the writer of its contents
+	Add(fi *pb.FileInfo, name string) (io.Writer, error)
+	Close() error
+}
+
+type tarGzWriter struct {
+	gz *gzip.Writer
+	tw *tar.Writer
+}
+
+func (w *tarGzWriter) Add(fi *pb.FileInfo, name string) (io.Writer, error) {
+	hdr := &tar.Header{
+		Name:     name,
+		Mode:     0644,
+		Size:     fi.Size,
+		ModTime:  time.UnixMilli(fi.LastModifiedTime),
+		Typeflag: tar.TypeReg,
+	}
+	if fi.Dir {
+		hdr.Name += "/"
+		hdr.Mode = 0755
+		hdr.Size = 0
+		hdr.Typeflag = tar.TypeDir
+	}
+	return w.tw, w.tw.WriteHeader(hdr)
+}
+
+func (w *tarGzWriter) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+type zipWriter struct {
+	zw *zip.Writer
+}
+
+func (w *zipWriter) Add(fi *pb.FileInfo, name string) (io.Writer, error) {
+	hdr := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.UnixMilli(fi.LastModifiedTime),
+	}
+	if fi.Dir {
+		hdr.Name += "/"
+		hdr.Method = zip.Store
+	}
+	return w.zw.CreateHeader(hdr)
+}
+
+func (w *zipWriter) Close() error {
+	return w.zw.Close()
+}
+
+// ArchiveFormat detect the archive format by the suffix of file name
+func ArchiveFormat(name string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		return internal.TarGz, nil
+	case strings.HasSuffix(name, ".zip"):
+		return internal.Zip, nil
+	}
+	return "", status.ErrArchiveFormat
+}
+
+func newArchiveWriter(format string, writer io.Writer) (archiveWriter, error) {
+	switch format {
+	case internal.TarGz:
+		gz := gzip.NewWriter(writer)
+		return &tarGzWriter{gz: gz, tw: tar.NewWriter(gz)}, nil
+	case internal.Zip:
+		return &zipWriter{zw: zip.NewWriter(writer)}, nil
+	}
+	return nil, status.ErrArchiveFormat
+}
+
+// countWriter count the bytes written and keep the write error
+type countWriter struct {
+	writer io.Writer
+	n      int64
+	err    error
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.n += int64(n)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// ArchiveFailedSuffix the suffix of note entry which is added to the archive for every file failed to download
+const ArchiveFailedSuffix = ".FAILED"
+
+// addFailedNote add the note entry beside the incomplete entry to explain why it is incomplete
+func addFailedNote(aw archiveWriter, name string, err error) error {
+	note := []byte(err.Error() + "\n")
+	w, werr := aw.Add(&pb.FileInfo{Size: int64(len(note)), LastModifiedTime: time.Now().UnixMilli()}, name+ArchiveFailedSuffix)
+	if werr != nil {
+		return werr
+	}
+	_, werr = w.Write(note)
+	return werr
+}
+
+// WriteArchive walk the remote directory and write it to writer as a tar.gz or zip stream,
+// every file is downloaded straight into the archive without staging it on disk.
+// The files which fail to download and the unreadable subdirectories are returned instead of aborting the archive,
+// their entries may be incomplete, empty or padded with NUL bytes, so a note entry with the suffix .FAILED is added for each of them
+func (f *FileSystem) WriteArchive(root, format string, writer io.Writer, fn stream.ProgressCallback) ([]transferFailure, error) {
+	root = path.Clean(f.concat(root))
+	aw, err := newArchiveWriter(format, writer)
+	if err != nil {
+		return nil, err
+	}
+	// the unreadable subdirectories are archived as empty directories with note entries
+	files, dirs, skipped, err := f.walkReadableDir(root)
+	if err != nil {
+		return nil, err
+	}
+	skippedErrs := make(map[string]error, len(skipped))
+	for _, s := range skipped {
+		skippedErrs[s.path] = s.err
+	}
+	// the parent directory is always sorted before its entries
+	entries := append(dirs, files...)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	// the entries are placed under the directory with the same name as root in the archive
+	prefix := path.Base(root)
+	if prefix == "/" {
+		prefix = ""
+	}
+	if fn == nil {
+		fn = func(present, total int64) {}
+	}
+	progress := newTransferProgress(fn, nil)
+	for _, fi := range files {
+		progress.total += fi.Size
+	}
+
+	var failures []transferFailure
+	for _, fi := range entries {
+		name := path.Join(prefix, strings.TrimPrefix(fi.Name, root))
+		w, err := aw.Add(fi, name)
+		if err != nil {
+			return failures, err
+		}
+		if err = skippedErrs[fi.Name]; err != nil {
+			failures = append(failures, transferFailure{path: fi.Name, err: err})
+			if werr := addFailedNote(aw, name, err); werr != nil {
+				return failures, werr
+			}
+			continue
+		}
+		if fi.Dir || fi.Size == 0 {
+			continue
+		}
+		cw := &countWriter{writer: w}
+		var last int64
+		// the file is read up to the size in the listing, which is written to the header of entry
+		err = f.DownloadFileRange(fi.Name, 0, fi.Size, cw, func(present, total int64) {
+			progress.add(present - last)
+			last = present
+		})
+		if cw.err != nil {
+			// the archive stream itself is broken
+			return failures, cw.err
+		}
+		if cw.n < fi.Size {
+			if err == nil {
+				// the file is truncated after listing
+				err = io.ErrUnexpectedEOF
+			}
+			if format == internal.TarGz {
+				// the tar entry must be filled up to its size
+				if _, werr := io.CopyN(w, zeroReader{}, fi.Size-cw.n); werr != nil {
+					return failures, werr
+				}
+				err = fmt.Errorf("padded with %d NUL bytes after %d of %d bytes: %w", fi.Size-cw.n, cw.n, fi.Size, err)
+			} else {
+				err = fmt.Errorf("truncated after %d of %d bytes: %w", cw.n, fi.Size, err)
+			}
+		}
+		if err != nil {
+			failures = append(failures, transferFailure{path: fi.Name, err: err})
+			if werr := addFailedNote(aw, name, err); werr != nil {
+				return failures, werr
+			}
+		}
+	}
+	return failures, aw.Close()
+}
+
+// Archive write the remote directory to writer as a tar.gz or zip stream
+func (f *FileSystem) Archive(root, format string, writer io.Writer, fn stream.ProgressCallback) error {
+	failures, err := f.WriteArchive(root, format, writer, fn)
+	if err == nil && len(failures) > 0 {
+		paths := make([]string, 0, len(failures))
+		for _, failure := range failures {
+			paths = append(paths, failure.path)
+		}
+		err = fmt.Errorf("%d files are incomplete in the archive: %s: %w",
+			len(failures), strings.Join(paths, ", "), failures[0].err)
+	}
+	return err
+}
+
+func (f *FileSystem) dumpArchive(args []string) {
+	if len(args) < 2 {
+		util.ErrorBy(status.ErrProvideParams)
+		return
+	}
+	src, dest := util.Trim(args[0]), util.Trim(args[1])
+	var format string
+	if format, f.Error = ArchiveFormat(dest); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var fp *os.File
+	if fp, f.Error = os.Create(dest); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var failures []transferFailure
+	failures, f.Error = f.WriteArchive(src, format, fp, f.Progress(progressbar.New(dest).HideSavePath()))
+	if err := fp.Close(); f.Error == nil {
+		f.Error = err
+	}
+	if util.AssertErrorNotNil(f.Error) {
+		_ = os.Remove(dest)
+		return
+	}
+	if len(failures) > 0 {
+		// the archive is kept, but the incomplete entries must not be taken as the original files
+		f.Error = fmt.Errorf("%d files are incomplete in %s, see the entries with suffix %s",
+			len(failures), dest, ArchiveFailedSuffix)
+		util.ErrorBy(f.Error)
+		f.dumpTransferFailures(failures)
+		return
+	}
+	util.Info("archive successfully: %s", util.HiRed(dest))
+}
@@ -0,0 +1,172 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+)
+
+const (
+	// the listed directories are cached for a short time, so repeated tab presses don't hammer the device
+	CompleteCacheTTL = time.Second * 5
+	completeTimeout  = time.Second * 2
+)
+
+type dirCacheEntry struct {
+	names  []string
+	expire time.Time
+}
+
+// the positions of remote path arguments for fs subcommands
+var remotePathArgs = map[string][]int{
+	internal.Upload:        {1},
+	internal.ForceUpload:   {1},
+	internal.Download:      {0},
+	internal.ForceDownload: {0},
+	internal.List:          {0},
+	internal.Cd:            {0},
+	internal.Create:        {0},
+	internal.Delete:        {0},
+	internal.MkDir:         {0},
+	internal.RmDir:         {0},
+	internal.ReadText:      {0},
+	internal.Hash:          {0},
+	internal.Sync:          {1},
+	internal.Find:          {0},
+	internal.Du:            {0},
+	internal.Top:           {0},
+	internal.Cat:           {0},
+	internal.Head:          {0},
+	internal.Tail:          {0},
+	internal.Edit:          {0},
+	internal.Archive:       {0},
+	internal.WriteText:     {0},
+	internal.AppendText:    {0},
+	internal.Move:          {0, 1},
+	internal.Copy:          {0, 1},
+	internal.Rename:        {0, 1},
+}
+
+// the flag options of fs subcommands
+var subcommandFlags = map[string][]string{
+	internal.Upload:   {OptionRecursive, OptionVerify},
+	internal.Download: {OptionRecursive, OptionVerify},
+	internal.Create:   {OptionYes},
+	internal.Delete:   {OptionYes},
+	internal.MkDir:    {OptionYes},
+	internal.RmDir:    {OptionYes},
+	internal.Move:     {OptionYes},
+	internal.Copy:     {OptionYes},
+	internal.Rename:   {OptionYes},
+	internal.Sync:     {OptionPull, OptionDelete, OptionDryRun, OptionHash},
+	internal.Cat:      {OptionFollow},
+	internal.Head:     {OptionFollow},
+	internal.Tail:     {OptionFollow},
+}
+
+// the options with an integer value of fs subcommands
+var subcommandIntOptions = map[string]string{
+	internal.Du:   OptionDepth,
+	internal.Top:  OptionTop,
+	internal.Cat:  OptionLines,
+	internal.Head: OptionLines,
+	internal.Tail: OptionLines,
+}
+
+// IsRemotePathArg check whether the argument following args of fs subcommand is a remote path,
+// the options and their values in args are not counted as arguments
+func IsRemotePathArg(subcommand string, args []string) bool {
+	if option, ok := subcommandIntOptions[subcommand]; ok {
+		var err error
+		// the value of option is being typed
+		if args, _, err = intOption(args, option, 0); err != nil {
+			return false
+		}
+	}
+	args, options := splitOptions(args, subcommandFlags[subcommand]...)
+	positions := remotePathArgs[subcommand]
+	// the remote directory is the source when pulling
+	if subcommand == internal.Sync && options[OptionPull] {
+		positions = []int{0}
+	}
+	for _, i := range positions {
+		if i == len(args) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompletePath list the entries which match the path being typed, the relative path is
+// resolved against the current remote directory, and the directory names end with "/"
+func (f *FileSystem) CompletePath(word string) (list []string) {
+	dir, prefix := path.Split(word)
+	// the cleaned path is the key of cache, so "/sdcard/" and "/sdcard" share the entries
+	remote := path.Clean(dir)
+	if !strings.HasPrefix(dir, "/") {
+		base := f.remoteDir
+		if base == "" {
+			base = "/"
+		}
+		remote = path.Join(base, dir)
+	}
+	for _, name := range f.listDirCached(remote) {
+		if strings.HasPrefix(name, prefix) {
+			list = append(list, dir+name)
+		}
+	}
+	return
+}
+
+func (f *FileSystem) listDirCached(dir string) []string {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+
+	if entry, ok := f.dirCache[dir]; ok && time.Now().Before(entry.expire) {
+		return entry.names
+	}
+	ctx, cancel := context.WithTimeout(f.ctx, completeTimeout)
+	defer cancel()
+	list, err := f.resolver.ListDir(ctx, &pb.StringPair{First: dir, Second: "all"})
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(list.Values))
+	for _, fi := range list.Values {
+		name := path.Base(fi.Name)
+		if fi.Dir {
+			name += "/"
+		}
+		names = append(names, name)
+	}
+	if f.dirCache == nil {
+		f.dirCache = make(map[string]dirCacheEntry)
+	}
+	f.dirCache[dir] = dirCacheEntry{names: names, expire: time.Now().Add(CompleteCacheTTL)}
+	return names
+}
+
+// invalidateDirCache clear the cached directories after the remote files are changed
+func (f *FileSystem) invalidateDirCache() {
+	f.cacheMu.Lock()
+	defer f.cacheMu.Unlock()
+	f.dirCache = nil
+}
@@ -0,0 +1,200 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	pt "github.com/josexy/godroidcli/prettytable"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionDepth the max depth of directories to show relative to the root directory
+	OptionDepth = "--depth"
+	// OptionTop the number of largest files to show
+	OptionTop = "-n"
+	// DefaultDuDepth show the root directory and its sub directories by default
+	DefaultDuDepth = 1
+	// DefaultTopFiles the number of largest files to show by default
+	DefaultTopFiles = 20
+)
+
+// duNode the total size and number of files under the directory
+type duNode struct {
+	name     string
+	size     int64
+	files    int
+	children []*duNode
+}
+
+// intOption separate the option with an integer value from the arguments
+func intOption(args []string, option string, value int) ([]string, int, error) {
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		if util.Trim(args[i]) != option {
+			rest = append(rest, args[i])
+			continue
+		}
+		if i+1 >= len(args) {
+			return nil, 0, fmt.Errorf("missing value of option %q", option)
+		}
+		n, err := util.StrToInt(util.Trim(args[i+1]))
+		if err != nil || n < 0 {
+			return nil, 0, fmt.Errorf("invalid value of option %q: %s", option, args[i+1])
+		}
+		value = n
+		i++
+	}
+	return rest, value, nil
+}
+
+// pathArg the remote path of the first argument, or current directory if it is omitted
+func (f *FileSystem) pathArg(args []string) (string, error) {
+	dir := f.remoteDir
+	if len(args) > 0 {
+		dir = f.concat(util.Trim(args[0]))
+	}
+	if dir == "" {
+		return "", status.ErrPathEmpty
+	}
+	return dir, nil
+}
+
+// buildDuTree sum up the sizes of files into all their parent directories up to the root
+func buildDuTree(root string, files, dirs []*pb.FileInfo) *duNode {
+	tree := &duNode{name: root}
+	nodes := map[string]*duNode{root: tree}
+	// the parent directory is always listed before its sub directories
+	for _, fi := range dirs {
+		node := &duNode{name: fi.Name}
+		nodes[fi.Name] = node
+		if parent, ok := nodes[path.Dir(fi.Name)]; ok {
+			parent.children = append(parent.children, node)
+		}
+	}
+	for _, fi := range files {
+		for dir := path.Dir(fi.Name); ; dir = path.Dir(dir) {
+			if node, ok := nodes[dir]; ok {
+				node.size += fi.Size
+				node.files++
+			}
+			if dir == root || dir == "/" || dir == "." {
+				break
+			}
+		}
+	}
+	return tree
+}
+
+// flatten list the directories not deeper than depth, and the larger ones come first among siblings
+func (n *duNode) flatten(level, depth int, fn func(node *duNode, level int)) {
+	fn(n, level)
+	if level >= depth {
+		return
+	}
+	sort.Slice(n.children, func(i, j int) bool {
+		if n.children[i].size != n.children[j].size {
+			return n.children[i].size > n.children[j].size
+		}
+		return n.children[i].name < n.children[j].name
+	})
+	for _, child := range n.children {
+		child.flatten(level+1, depth, fn)
+	}
+}
+
+// DiskUsage calculate the total size of remote directory and its sub directories,
+// the unreadable subdirectories are skipped and returned
+func (f *FileSystem) DiskUsage(root string) (*duNode, []transferFailure, error) {
+	root = path.Clean(root)
+	files, dirs, skipped, err := f.walkReadableDir(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buildDuTree(root, files, dirs), skipped, nil
+}
+
+// TopFiles list the n largest files under the remote directory, the unreadable subdirectories are skipped and returned
+func (f *FileSystem) TopFiles(root string, n int) ([]*pb.FileInfo, []transferFailure, error) {
+	files, _, skipped, err := f.walkReadableDir(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.SliceStable(files, func(i, j int) bool { return files[i].Size > files[j].Size })
+	if n < len(files) {
+		files = files[:n]
+	}
+	return files, skipped, nil
+}
+
+func (f *FileSystem) dumpDiskUsage(args []string) {
+	var depth int
+	if args, depth, f.Error = intOption(args, OptionDepth, DefaultDuDepth); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var dir string
+	if dir, f.Error = f.pathArg(args); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var tree *duNode
+	var skipped []transferFailure
+	tree, skipped, f.Error = f.DiskUsage(dir)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	warnSkippedDirs(skipped)
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{util.Green("Path"), util.Yellow("Size"), util.Blue("Files")})
+	list := &pb.FileInfoList{}
+	tree.flatten(0, depth, func(node *duNode, level int) {
+		name := node.name
+		if level > 0 {
+			name = strings.Repeat("  ", level) + path.Base(node.name)
+		}
+		table.AddRow(pt.Row{util.Green(name), util.Yellow(util.CalcFileBytes(node.size)), util.Blue(util.IntToStr(node.files))})
+		list.Values = append(list.Values, &pb.FileInfo{Name: node.name, Size: node.size, Dir: true})
+	})
+	f.PrintResult(list, table)
+}
+
+func (f *FileSystem) dumpTopFiles(args []string) {
+	var n int
+	if args, n, f.Error = intOption(args, OptionTop, DefaultTopFiles); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var dir string
+	if dir, f.Error = f.pathArg(args); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var files []*pb.FileInfo
+	var skipped []transferFailure
+	files, skipped, f.Error = f.TopFiles(dir, n)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	warnSkippedDirs(skipped)
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{util.Green("Path"), util.Yellow("Size"), util.Blue("LastModifiedTime")})
+	for _, fi := range files {
+		table.AddRow(pt.Row{util.Green(fi.Name), util.Yellow(util.CalcFileBytes(fi.Size)), util.Blue(util.TimeOf(fi.LastModifiedTime))})
+	}
+	f.PrintResult(&pb.FileInfoList{Values: files}, table)
+}
@@ -0,0 +1,147 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+// defaultEditor the editor used if $EDITOR is not set
+func defaultEditor() string {
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+	return "vi"
+}
+
+// runEditor open the local file with $EDITOR and wait for it to exit
+func runEditor(file string) error {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = defaultEditor()
+	}
+	// the editor may contain arguments, such as "code --wait"
+	args := append(strings.Fields(editor), file)
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	return cmd.Run()
+}
+
+// uploadLocalFile upload the local file to remote file, the empty file is created instead
+// since the path of remote file is sent along with the first chunk of bytes stream
+func (f *FileSystem) uploadLocalFile(local, remote string) error {
+	fp, err := os.Open(local)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fp.Close() }()
+	info, err := fp.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 {
+		if err = f.DeleteFile(remote); err != nil {
+			return err
+		}
+		return f.CreateFile(remote)
+	}
+	return f.UploadFile(fp, remote)
+}
+
+// EditFile download the remote file to a temporary file and edit it, then upload it back if it is changed.
+// the upload is refused if the remote file is modified while editing, and the path of temporary file
+// which keeps the changes is returned along with the error
+func (f *FileSystem) EditFile(file string, edit func(local string) error) (changed bool, kept string, err error) {
+	var fi *pb.FileInfo
+	if fi, err = f.statFile(file); err != nil {
+		return
+	}
+	dir, err := os.MkdirTemp("", "godroidcli-edit-")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if kept == "" {
+			_ = os.RemoveAll(dir)
+		}
+	}()
+	// keep the base name so that the editor can recognize the file type
+	local := filepath.Join(dir, path.Base(file))
+	fp, err := os.Create(local)
+	if err != nil {
+		return
+	}
+	err = f.DownloadFile(file, fp, nil)
+	_ = fp.Close()
+	if err != nil {
+		return
+	}
+
+	before, err := hashLocalFile(local, HashSHA256)
+	if err != nil {
+		return
+	}
+	if err = edit(local); err != nil {
+		return
+	}
+	after, err := hashLocalFile(local, HashSHA256)
+	if err != nil || before == after {
+		return
+	}
+
+	changed = true
+	var latest *pb.FileInfo
+	if latest, err = f.statFile(file); err == nil && latest.LastModifiedTime != fi.LastModifiedTime {
+		err = status.ErrRemoteModified
+	}
+	if err == nil {
+		err = f.uploadLocalFile(local, file)
+	}
+	if err != nil {
+		kept = local
+	}
+	return
+}
+
+func (f *FileSystem) dumpEditFile(file string) {
+	if file == "" {
+		util.ErrorBy(status.ErrPathEmpty)
+		return
+	}
+	file = f.concat(file)
+	var changed bool
+	var kept string
+	changed, kept, f.Error = f.EditFile(file, runEditor)
+	if util.AssertErrorNotNil(f.Error) {
+		if kept != "" {
+			util.Warn("the changes are kept in: %s", kept)
+		}
+		return
+	}
+	if changed {
+		util.Info("upload successfully: %s", util.HiGreen(file))
+	} else {
+		util.Info("no changes: %s", file)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pt "github.com/josexy/godroidcli/prettytable"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// FindName match the base name of file with shell pattern, such as '*.log'
+	FindName = "-name"
+	// FindSize match the file size, such as +10M, -512k or 100
+	FindSize = "-size"
+	// FindMtime match the days since the file was last modified, such as -2 or +30
+	FindMtime = "-mtime"
+	// FindType match the file type, f for regular file and d for directory
+	FindType = "-type"
+)
+
+var sizeUnits = map[byte]int64{
+	'c': 1,
+	'k': 1 << 10,
+	'K': 1 << 10,
+	'M': 1 << 20,
+	'G': 1 << 30,
+}
+
+// findPredicate report whether the file matches the expression
+type findPredicate func(fi *pb.FileInfo, now time.Time) bool
+
+// compareNumber split "+N" or "-N" into sign and number, the sign is 1 for greater, -1 for less and 0 for equal
+func compareNumber(s string) (sign int, n string) {
+	switch {
+	case strings.HasPrefix(s, "+"):
+		return 1, s[1:]
+	case strings.HasPrefix(s, "-"):
+		return -1, s[1:]
+	}
+	return 0, s
+}
+
+func compareWith(sign int, value, n int64) bool {
+	switch sign {
+	case 1:
+		return value > n
+	case -1:
+		return value < n
+	}
+	return value == n
+}
+
+func parseFindPredicate(key, value string) (findPredicate, error) {
+	switch key {
+	case FindName:
+		if _, err := path.Match(value, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", value, err)
+		}
+		return func(fi *pb.FileInfo, _ time.Time) bool {
+			ok, _ := path.Match(value, path.Base(fi.Name))
+			return ok
+		}, nil
+	case FindSize:
+		sign, s := compareNumber(value)
+		unit := int64(512)
+		if s != "" {
+			if u, ok := sizeUnits[s[len(s)-1]]; ok {
+				unit, s = u, s[:len(s)-1]
+			}
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q", value)
+		}
+		// unlike find command, the size is only rounded up to the unit for the exact match,
+		// so that -1M matches the files smaller than 1MB rather than the empty files
+		return func(fi *pb.FileInfo, _ time.Time) bool {
+			if sign == 0 {
+				return (fi.Size+unit-1)/unit == n
+			}
+			return compareWith(sign, fi.Size, n*unit)
+		}, nil
+	case FindMtime:
+		sign, s := compareNumber(value)
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid days %q", value)
+		}
+		return func(fi *pb.FileInfo, now time.Time) bool {
+			days := int64(now.Sub(time.UnixMilli(fi.LastModifiedTime)) / (24 * time.Hour))
+			return compareWith(sign, days, n)
+		}, nil
+	case FindType:
+		if value != "f" && value != "d" {
+			return nil, fmt.Errorf("invalid type %q, f or d is expected", value)
+		}
+		return func(fi *pb.FileInfo, _ time.Time) bool {
+			return fi.Dir == (value == "d")
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown predicate %q", key)
+}
+
+// parseFindPredicates parse the expressions in pairs, all of them must be matched
+func parseFindPredicates(args []string) ([]findPredicate, error) {
+	if len(args)%2 != 0 {
+		return nil, fmt.Errorf("missing value of predicate %q", args[len(args)-1])
+	}
+	var predicates []findPredicate
+	for i := 0; i < len(args); i += 2 {
+		p, err := parseFindPredicate(util.Trim(args[i]), util.Trim(args[i+1]))
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, p)
+	}
+	return predicates, nil
+}
+
+// Find walk the remote directory and return the files and directories which match all predicates,
+// the unreadable subdirectories are skipped and returned
+func (f *FileSystem) Find(root string, predicates []findPredicate) ([]*pb.FileInfo, []transferFailure, error) {
+	files, dirs, skipped, err := f.walkReadableDir(root)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	var matches []*pb.FileInfo
+next:
+	for _, fi := range append(dirs, files...) {
+		for _, p := range predicates {
+			if !p(fi, now) {
+				continue next
+			}
+		}
+		matches = append(matches, fi)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Name < matches[j].Name })
+	return matches, skipped, nil
+}
+
+func (f *FileSystem) dumpFind(dir string, args []string) {
+	var predicates []findPredicate
+	if predicates, f.Error = parseFindPredicates(args); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	var matches []*pb.FileInfo
+	var skipped []transferFailure
+	matches, skipped, f.Error = f.Find(f.concat(dir), predicates)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	warnSkippedDirs(skipped)
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{
+		util.Green("Path"),
+		util.Yellow("Size"),
+		util.Blue("LastModifiedTime"),
+		util.Red("Dir"),
+	})
+	for _, fi := range matches {
+		table.AddRow(pt.Row{
+			util.Green(fi.Name),
+			util.Yellow(util.CalcFileBytes(fi.Size)),
+			util.Blue(util.TimeOf(fi.LastModifiedTime)),
+			util.Red(util.BoolToStr(fi.Dir)),
+		})
+	}
+	f.PrintResult(&pb.FileInfoList{Values: matches}, table)
+}
@@ -0,0 +1,345 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pt "github.com/josexy/godroidcli/prettytable"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionYes apply the destructive operation without confirmation
+	OptionYes = "--yes"
+	// MaxUnconfirmedMatches the destructive operation requires confirmation if more paths match the pattern
+	MaxUnconfirmedMatches = 10
+)
+
+// the operations which support glob patterns, and whether they are destructive
+var globOperands = map[string]bool{
+	internal.Delete: true,
+	internal.RmDir:  true,
+	internal.Move:   true,
+	internal.Copy:   true,
+}
+
+var destructiveOperands = map[string]bool{
+	internal.Delete: true,
+	internal.RmDir:  true,
+	internal.Move:   true,
+}
+
+// dirLister list the entries of directory
+type dirLister func(dir string) ([]*pb.FileInfo, error)
+
+func hasMeta(s string) bool {
+	return strings.ContainsAny(s, "*?[")
+}
+
+// hasGlob check whether the argument is a glob pattern, the quoted argument is taken literally
+func hasGlob(arg string) bool {
+	if strings.HasPrefix(arg, "\"") || strings.HasPrefix(arg, "'") {
+		return false
+	}
+	return hasMeta(arg)
+}
+
+type globber struct {
+	list    dirLister
+	matches map[string]*pb.FileInfo
+}
+
+func (g *globber) add(name string, fi *pb.FileInfo) {
+	g.matches[name] = &pb.FileInfo{
+		Name:             name,
+		Size:             fi.Size,
+		Dir:              fi.Dir,
+		LastModifiedTime: fi.LastModifiedTime,
+		Owner:            fi.Owner,
+		Readable:         fi.Readable,
+		Writable:         fi.Writable,
+		Executable:       fi.Executable,
+	}
+}
+
+// walk match the entries of directory, the unreadable subdirectories are skipped
+func (g *globber) walk(dir string, segs []string) {
+	if entries, err := g.list(dir); err == nil {
+		_ = g.match(dir, entries, segs)
+	}
+}
+
+func (g *globber) match(dir string, entries []*pb.FileInfo, segs []string) error {
+	seg, rest := segs[0], segs[1:]
+	if seg == "**" {
+		// match zero directory
+		if len(rest) > 0 {
+			if err := g.match(dir, entries, rest); err != nil {
+				return err
+			}
+		}
+		// match one or more directories
+		for _, fi := range entries {
+			name := path.Join(dir, path.Base(fi.Name))
+			if len(rest) == 0 {
+				g.add(name, fi)
+			}
+			if fi.Dir {
+				g.walk(name, segs)
+			}
+		}
+		return nil
+	}
+	for _, fi := range entries {
+		base := path.Base(fi.Name)
+		// the hidden files are matched only if the pattern starts with "."
+		if strings.HasPrefix(base, ".") && !strings.HasPrefix(seg, ".") {
+			continue
+		}
+		ok, err := path.Match(seg, base)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		name := path.Join(dir, base)
+		if len(rest) == 0 {
+			g.add(name, fi)
+		} else if fi.Dir {
+			g.walk(name, rest)
+		}
+	}
+	return nil
+}
+
+// expandGlob find the paths which match the pattern separated by "/", the pattern supports
+// "*", "?", "[...]" and "**" which matches zero or more directories
+func expandGlob(pattern string, list dirLister) ([]*pb.FileInfo, error) {
+	base := "."
+	segs := strings.Split(pattern, "/")
+	if strings.HasPrefix(pattern, "/") {
+		base, segs = "/", segs[1:]
+	}
+	// the literal prefix of pattern
+	i := 0
+	for ; i < len(segs) && !hasMeta(segs[i]); i++ {
+		base = path.Join(base, segs[i])
+	}
+	var rest []string
+	for _, seg := range segs[i:] {
+		if seg != "" {
+			rest = append(rest, seg)
+		}
+	}
+	if len(rest) == 0 {
+		return nil, nil
+	}
+	entries, err := list(base)
+	if err != nil {
+		return nil, err
+	}
+	g := &globber{list: list, matches: make(map[string]*pb.FileInfo)}
+	if err = g.match(base, entries, rest); err != nil {
+		return nil, err
+	}
+	matches := make([]*pb.FileInfo, 0, len(g.matches))
+	for _, fi := range g.matches {
+		matches = append(matches, fi)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Name < matches[j].Name })
+	return matches, nil
+}
+
+// listLocalDir list the entries of local directory
+func listLocalDir(dir string) ([]*pb.FileInfo, error) {
+	entries, err := os.ReadDir(filepath.FromSlash(dir))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.FileInfo, 0, len(entries))
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		list = append(list, &pb.FileInfo{
+			Name:             entry.Name(),
+			Size:             info.Size(),
+			Dir:              entry.IsDir(),
+			LastModifiedTime: info.ModTime().UnixMilli(),
+		})
+	}
+	return list, nil
+}
+
+// ExpandGlob find the remote paths which match the pattern
+func (f *FileSystem) ExpandGlob(pattern string) ([]*pb.FileInfo, error) {
+	return expandGlob(f.concat(pattern), func(dir string) ([]*pb.FileInfo, error) {
+		list, err := f.ListDir(dir, "all")
+		if err != nil {
+			return nil, err
+		}
+		return list.Values, nil
+	})
+}
+
+// confirm ask the user whether to continue, it is declined if the stdin is closed, such as running a script
+func confirm(format string, v ...interface{}) bool {
+	util.Print("%s [y/N]: ", fmt.Sprintf(format, v...))
+	reader := bufio.NewScanner(os.Stdin)
+	if !reader.Scan() {
+		return false
+	}
+	answer := strings.ToLower(strings.TrimSpace(reader.Text()))
+	return answer == "y" || answer == "yes"
+}
+
+// dumpGlobResults show the result of every path, and the command fails if any of them failed
+func (f *FileSystem) dumpGlobResults(paths []string, errs []error) {
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{util.Green("Path"), util.Yellow("Result")})
+	rows := make([]map[string]interface{}, 0, len(paths))
+	var failed int
+	for i, p := range paths {
+		result := "ok"
+		if errs[i] != nil {
+			failed++
+			result = errs[i].Error()
+			table.AddRow(pt.Row{util.Green(p), util.Red(result)})
+		} else {
+			table.AddRow(pt.Row{util.Green(p), util.Yellow(result)})
+		}
+		rows = append(rows, map[string]interface{}{"path": p, "result": result})
+	}
+	f.PrintRows(rows, table)
+	if failed > 0 {
+		f.Error = fmt.Errorf("%d of %d paths failed", failed, len(paths))
+		util.ErrorBy(f.Error)
+	}
+}
+
+// dumpGlobOperand apply the operation to every remote path which matches the pattern
+func (f *FileSystem) dumpGlobOperand(op string, args []string, yes bool) {
+	var matches []*pb.FileInfo
+	matches, f.Error = f.ExpandGlob(args[0])
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	if len(matches) == 0 {
+		util.Warn("no path matches: %s", args[0])
+		return
+	}
+	if destructiveOperands[op] && len(matches) > MaxUnconfirmedMatches && !yes &&
+		!confirm("%s %d paths which match %s?", op, len(matches), args[0]) {
+		f.Error = status.ErrAborted
+		util.ErrorBy(f.Error)
+		return
+	}
+	var dest string
+	if len(args) > 1 {
+		dest = f.concat(util.Trim(args[1]))
+	}
+	paths := make([]string, len(matches))
+	errs := make([]error, len(matches))
+	var moves []movePair
+	for i, fi := range matches {
+		paths[i] = fi.Name
+		switch op {
+		case internal.Delete,
+			internal.RmDir:
+			errs[i] = f.remove(op, fi.Name)
+		case internal.Move:
+			target := path.Join(dest, path.Base(fi.Name))
+			if errs[i] = f.Move(fi.Name, target); errs[i] == nil {
+				moves = append(moves, movePair{op, fi.Name, target})
+			}
+		case internal.Copy:
+			errs[i] = f.Copy(fi.Name, path.Join(dest, path.Base(fi.Name)))
+		}
+	}
+	f.recordMoves(moves)
+	f.invalidateDirCache()
+	f.dumpGlobResults(paths, errs)
+}
+
+// dumpGlobTransfer upload or download every path which matches the pattern into the directory,
+// the directories are skipped unless transferring recursively
+func (f *FileSystem) dumpGlobTransfer(op, pattern, dest string, options map[string]bool) {
+	var matches []*pb.FileInfo
+	if op == internal.Upload {
+		matches, f.Error = expandGlob(filepath.ToSlash(pattern), listLocalDir)
+		// the files are uploaded into the remote directory
+		if !strings.HasSuffix(dest, "/") {
+			dest += "/"
+		}
+	} else {
+		matches, f.Error = f.ExpandGlob(pattern)
+		if dest == "" {
+			dest = "."
+		}
+		if f.Error == nil {
+			f.Error = os.MkdirAll(dest, 0755)
+		}
+	}
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	if len(matches) == 0 {
+		util.Warn("no path matches: %s", pattern)
+		return
+	}
+	var paths []string
+	var errs []error
+	for _, fi := range matches {
+		src := fi.Name
+		if op == internal.Upload {
+			src = filepath.FromSlash(src)
+		}
+		var err error
+		switch {
+		case !fi.Dir:
+			err = f.transferFile(op, src, dest, options[OptionVerify])
+		case !options[OptionRecursive]:
+			continue
+		default:
+			var failures []transferFailure
+			if op == internal.Upload {
+				failures, err = f.UploadDir(src, dest, options[OptionVerify])
+			} else {
+				failures, err = f.DownloadDir(src, dest, options[OptionVerify])
+			}
+			if err == nil && len(failures) > 0 {
+				err = fmt.Errorf("%d files failed to transfer", len(failures))
+			}
+		}
+		paths = append(paths, src)
+		errs = append(errs, err)
+	}
+	if op == internal.Upload {
+		f.invalidateDirCache()
+	}
+	f.dumpGlobResults(paths, errs)
+}
@@ -0,0 +1,101 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	pt "github.com/josexy/godroidcli/prettytable"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionVerify compare the digests of local file and remote file after transfer
+	OptionVerify = "--verify"
+	HashSHA256   = "sha256"
+	HashMD5      = "md5"
+)
+
+// GetFileHash calculate the hex digest of remote file, the algorithm is sha256 or md5
+func (f *FileSystem) GetFileHash(file, algorithm string) (*pb.String, error) {
+	return f.resolver.GetFileHash(f.ctx, &pb.StringPair{First: file, Second: algorithm})
+}
+
+func newHash(algorithm string) (hash.Hash, error) {
+	switch algorithm {
+	case HashSHA256:
+		return sha256.New(), nil
+	case HashMD5:
+		return md5.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported hash algorithm: %s", algorithm)
+}
+
+// hashLocalFile calculate the hex digest of local file
+func hashLocalFile(file, algorithm string) (string, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return "", err
+	}
+	fp, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = fp.Close() }()
+	if _, err = io.Copy(h, fp); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifyFile compare the sha256 digests of local file and remote file
+func (f *FileSystem) verifyFile(local, remote string) error {
+	digest, err := hashLocalFile(local, HashSHA256)
+	if err != nil {
+		return err
+	}
+	remoteDigest, err := f.GetFileHash(remote, HashSHA256)
+	if err != nil {
+		return err
+	}
+	if digest != remoteDigest.Value {
+		return fmt.Errorf("%w: %s != %s", status.ErrHashMismatch, digest, remoteDigest.Value)
+	}
+	return nil
+}
+
+func (f *FileSystem) dumpFileHash(file, algorithm string) {
+	if _, f.Error = newHash(algorithm); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	file = f.concat(file)
+	var digest *pb.String
+	digest, f.Error = f.GetFileHash(file, algorithm)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{util.Green("Path"), util.Yellow("Algorithm"), util.Blue("Digest")})
+	table.AddRow(pt.Row{util.Green(file), util.Yellow(algorithm), util.Blue(digest.Value)})
+	f.PrintResult(digest, table)
+}
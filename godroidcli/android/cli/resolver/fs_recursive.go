@@ -0,0 +1,361 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/josexy/godroidcli/android/cli/stream"
+	"github.com/josexy/godroidcli/android/internal"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/progressbar"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionRecursive upload or download a directory recursively
+	OptionRecursive = "-r"
+	// MaxTransferWorkers the number of files transferred in parallel
+	MaxTransferWorkers = 4
+)
+
+type transferTask struct {
+	src   string
+	dest  string
+	size  int64
+	mtime int64
+}
+
+type transferFailure struct {
+	path string
+	err  error
+}
+
+// transferProgress aggregate the progress of all parallel transfers
+type transferProgress struct {
+	mu      sync.Mutex
+	present int64
+	total   int64
+	fn      stream.ProgressCallback
+}
+
+func newTransferProgress(fn stream.ProgressCallback, tasks []transferTask) *transferProgress {
+	p := &transferProgress{fn: fn}
+	for _, t := range tasks {
+		p.total += t.size
+	}
+	return p
+}
+
+func (p *transferProgress) add(n int64) {
+	p.mu.Lock()
+	p.present += n
+	p.fn(p.present, p.total)
+	p.mu.Unlock()
+}
+
+// countReader report the number of bytes read
+type countReader struct {
+	reader io.Reader
+	fn     func(n int64)
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.fn(int64(n))
+	}
+	return n, err
+}
+
+// SetLastModified set the last modified time (unix milliseconds) of remote file or directory
+func (f *FileSystem) SetLastModified(file string, mtime int64) (err error) {
+	_, err = f.resolver.SetLastModified(f.ctx, &pb.StringLong{First: file, Second: mtime})
+	return
+}
+
+// runTransfers run the tasks in parallel and return the failed tasks
+func runTransfers(tasks []transferTask, fn func(task transferTask) error) (failures []transferFailure) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	ch := make(chan transferTask)
+	workers := MaxTransferWorkers
+	if len(tasks) < workers {
+		workers = len(tasks)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range ch {
+				if err := fn(task); err != nil {
+					mu.Lock()
+					failures = append(failures, transferFailure{path: task.src, err: err})
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, task := range tasks {
+		ch <- task
+	}
+	close(ch)
+	wg.Wait()
+	sort.Slice(failures, func(i, j int) bool { return failures[i].path < failures[j].path })
+	return
+}
+
+// walkRemoteDir list all files and directories under the remote directory, any error aborts the walk
+func (f *FileSystem) walkRemoteDir(root string) (files, dirs []*pb.FileInfo, err error) {
+	files, dirs, _, err = f.walkDir(root, false)
+	return
+}
+
+// walkReadableDir is the same as walkRemoteDir, but the unreadable subdirectories are skipped and returned,
+// such as the protected directories of other applications
+func (f *FileSystem) walkReadableDir(root string) (files, dirs []*pb.FileInfo, skipped []transferFailure, err error) {
+	return f.walkDir(root, true)
+}
+
+func (f *FileSystem) walkDir(root string, skip bool) (files, dirs []*pb.FileInfo, skipped []transferFailure, err error) {
+	queue := []string{root}
+	for len(queue) > 0 {
+		dir := queue[0]
+		queue = queue[1:]
+		list, lerr := f.ListDir(dir, "all")
+		if lerr != nil {
+			if !skip || dir == root {
+				err = lerr
+				return
+			}
+			skipped = append(skipped, transferFailure{path: dir, err: lerr})
+			continue
+		}
+		for _, fi := range list.Values {
+			fi.Name = path.Join(dir, path.Base(fi.Name))
+			if fi.Dir {
+				dirs = append(dirs, fi)
+				queue = append(queue, fi.Name)
+			} else {
+				files = append(files, fi)
+			}
+		}
+	}
+	return
+}
+
+// warnSkippedDirs print the unreadable directories skipped by walkReadableDir
+func warnSkippedDirs(skipped []transferFailure) {
+	for _, s := range skipped {
+		util.Warn("skip the unreadable directory %s: %s", s.path, s.err.Error())
+	}
+}
+
+// walkLocalDir list all files and directories under the local directory, the root is excluded
+func walkLocalDir(root string) (files, dirs []*pb.FileInfo, err error) {
+	err = filepath.WalkDir(root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if file == root {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		fi := &pb.FileInfo{
+			Name:             file,
+			Size:             info.Size(),
+			Dir:              d.IsDir(),
+			LastModifiedTime: info.ModTime().UnixMilli(),
+		}
+		if fi.Dir {
+			dirs = append(dirs, fi)
+		} else if info.Mode().IsRegular() {
+			files = append(files, fi)
+		}
+		return nil
+	})
+	return
+}
+
+// downloadTask download the remote file to local file and keep its modification time
+func (f *FileSystem) downloadTask(progress *transferProgress, verify bool) func(task transferTask) error {
+	return func(task transferTask) error {
+		var last int64
+		// the partial file is resumed if the listed remote file is not changed
+		info := &pb.FileInfo{Size: task.size, LastModifiedTime: task.mtime}
+		err := f.downloadPartFile(task.src, task.dest, info, func(present, total int64) {
+			progress.add(present - last)
+			last = present
+		})
+		if err != nil {
+			return err
+		}
+		mtime := time.UnixMilli(task.mtime)
+		if err = os.Chtimes(task.dest, mtime, mtime); err != nil || !verify {
+			return err
+		}
+		return f.verifyFile(task.dest, task.src)
+	}
+}
+
+// uploadTask upload the local file to remote file and keep its modification time
+func (f *FileSystem) uploadTask(progress *transferProgress, verify bool) func(task transferTask) error {
+	var warnOnce sync.Once
+	return func(task transferTask) error {
+		fp, err := os.Open(task.src)
+		if err != nil {
+			return err
+		}
+		err = f.UploadFile(&countReader{reader: fp, fn: progress.add}, task.dest)
+		_ = fp.Close()
+		if err != nil {
+			return err
+		}
+		// the modification time is best-effort, some storages of Android reject setting it
+		if err = f.SetLastModified(task.dest, task.mtime); err != nil {
+			warnOnce.Do(func() {
+				util.Warn("failed to set the modification time of uploaded files: %s", err.Error())
+			})
+		}
+		if !verify {
+			return nil
+		}
+		return f.verifyFile(task.src, task.dest)
+	}
+}
+
+// DownloadDir download the remote directory recursively, and compare the digests of files if verify
+func (f *FileSystem) DownloadDir(src, dest string, verify bool) ([]transferFailure, error) {
+	src = f.concat(src)
+	if dest == "" {
+		dest = path.Base(src)
+	} else if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		dest = filepath.Join(dest, path.Base(src))
+	}
+	files, dirs, err := f.walkRemoteDir(src)
+	if err != nil {
+		return nil, err
+	}
+	localPath := func(remote string) string {
+		return filepath.Join(dest, filepath.FromSlash(strings.TrimPrefix(remote, src)))
+	}
+	if err = os.MkdirAll(dest, 0755); err != nil {
+		return nil, err
+	}
+	for _, dir := range dirs {
+		if err = os.MkdirAll(localPath(dir.Name), 0755); err != nil {
+			return nil, err
+		}
+	}
+
+	tasks := make([]transferTask, 0, len(files))
+	for _, fi := range files {
+		tasks = append(tasks, transferTask{src: fi.Name, dest: localPath(fi.Name), size: fi.Size, mtime: fi.LastModifiedTime})
+	}
+	progress := newTransferProgress(f.Progress(progressbar.New(dest).HideSavePath()), tasks)
+	failures := runTransfers(tasks, f.downloadTask(progress, verify))
+
+	// set the time of directories after their contents are written, the deepest first
+	for i := len(dirs) - 1; i >= 0; i-- {
+		mtime := time.UnixMilli(dirs[i].LastModifiedTime)
+		_ = os.Chtimes(localPath(dirs[i].Name), mtime, mtime)
+	}
+	util.Info("download %d files to: %s", len(tasks)-len(failures), util.HiRed(dest))
+	return failures, nil
+}
+
+// UploadDir upload the local directory recursively, and compare the digests of files if verify
+func (f *FileSystem) UploadDir(src, dest string, verify bool) ([]transferFailure, error) {
+	src = filepath.Clean(src)
+	if dest == "" || strings.HasSuffix(dest, ".") || strings.HasSuffix(dest, "/") {
+		dest = path.Join(dest, filepath.Base(src))
+	}
+	dest = f.concat(dest)
+
+	files, dirs, err := walkLocalDir(src)
+	if err != nil {
+		return nil, err
+	}
+	remotePath := func(local string) string {
+		rel, _ := filepath.Rel(src, local)
+		return path.Join(dest, filepath.ToSlash(rel))
+	}
+	// the directory may already exist, so the error is ignored
+	_ = f.MkDir(dest)
+	for _, dir := range dirs {
+		_ = f.MkDir(remotePath(dir.Name))
+	}
+
+	tasks := make([]transferTask, 0, len(files))
+	for _, fi := range files {
+		tasks = append(tasks, transferTask{src: fi.Name, dest: remotePath(fi.Name), size: fi.Size, mtime: fi.LastModifiedTime})
+	}
+
+	progress := newTransferProgress(f.Progress(progressbar.New(dest).HideSavePath()), tasks)
+	failures := runTransfers(tasks, f.uploadTask(progress, verify))
+
+	for i := len(dirs) - 1; i >= 0; i-- {
+		_ = f.SetLastModified(remotePath(dirs[i].Name), dirs[i].LastModifiedTime)
+	}
+	f.invalidateDirCache()
+	util.Info("upload %d files to: %s", len(tasks)-len(failures), util.HiRed(dest))
+	return failures, nil
+}
+
+// dumpTransferFailures show the files failed to transfer, and the command fails unless its error is already set
+func (f *FileSystem) dumpTransferFailures(failures []transferFailure) {
+	if len(failures) == 0 {
+		return
+	}
+	if f.Error == nil {
+		f.Error = fmt.Errorf("%d files failed to transfer", len(failures))
+		util.ErrorBy(f.Error)
+	}
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{util.Green("Path"), util.Red("Error")})
+	rows := make([]map[string]interface{}, 0, len(failures))
+	for _, failure := range failures {
+		table.AddRow(pt.Row{util.Green(failure.path), util.Red(failure.err.Error())})
+		rows = append(rows, map[string]interface{}{"path": failure.path, "error": failure.err.Error()})
+	}
+	f.PrintRows(rows, table)
+}
+
+func (f *FileSystem) dumpRecursiveUploadOrDownload(s1, s2, op string, verify bool) {
+	var failures []transferFailure
+	switch op {
+	case internal.Upload:
+		failures, f.Error = f.UploadDir(s1, s2, verify)
+	case internal.Download:
+		failures, f.Error = f.DownloadDir(s1, s2, verify)
+	}
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	f.dumpTransferFailures(failures)
+}
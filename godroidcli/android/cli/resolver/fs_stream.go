@@ -0,0 +1,166 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path"
+
+	"github.com/josexy/godroidcli/android/cli/stream"
+	"github.com/josexy/godroidcli/android/internal"
+	"github.com/josexy/godroidcli/filter"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionLines the number of lines to show for head and tail
+	OptionLines = "-n"
+	// OptionFollow keep showing the lines appended to file until Ctrl+C is pressed
+	OptionFollow = "-f"
+	// DefaultLines show 10 lines by default
+	DefaultLines = 10
+	// TailWindowSize the size of bytes read from the end of file to find the last lines,
+	// which is doubled until enough lines are found
+	TailWindowSize = 64 << 10
+)
+
+// errStopStream stop receiving the bytes stream since enough lines are received
+var errStopStream = errors.New("stop streaming")
+
+// lineLimitWriter write the first n lines to writer, then errStopStream is returned
+type lineLimitWriter struct {
+	writer io.Writer
+	n      int
+}
+
+func (w *lineLimitWriter) Write(p []byte) (int, error) {
+	if w.n <= 0 {
+		return 0, errStopStream
+	}
+	for i, b := range p {
+		if b != '\n' {
+			continue
+		}
+		if w.n--; w.n == 0 {
+			if _, err := w.writer.Write(p[:i+1]); err != nil {
+				return 0, err
+			}
+			return i + 1, errStopStream
+		}
+	}
+	return w.writer.Write(p)
+}
+
+// StreamFile write the bytes stream of remote file from the offset to writer as they arrive,
+// and keep writing the appended bytes if follow until ctx is canceled
+func (f *FileSystem) StreamFile(ctx context.Context, src string, offset int64, follow bool, writer io.Writer) error {
+	s, err := f.resolver.DownloadGeneralFile(ctx, &pb.FileRange{Value: src, Offset: offset, Follow: follow})
+	return stream.HandleDownloadStream(s, err, writer, nil)
+}
+
+// statFile get the information of remote file from the entries of its parent directory
+func (f *FileSystem) statFile(file string) (*pb.FileInfo, error) {
+	list, err := f.ListDir(path.Dir(file), "all")
+	if err != nil {
+		return nil, err
+	}
+	for _, fi := range list.Values {
+		if path.Base(fi.Name) == path.Base(file) {
+			return fi, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", os.ErrNotExist, file)
+}
+
+// tailOffset find the offset where the last n lines of remote file start
+func (f *FileSystem) tailOffset(file string, size int64, n int) (int64, error) {
+	if n <= 0 || size <= 0 {
+		return size, nil
+	}
+	for window := int64(TailWindowSize); ; window *= 2 {
+		start := size - window
+		if start < 0 {
+			start = 0
+		}
+		buf := bytes.NewBuffer(nil)
+		if err := f.DownloadFileRange(file, start, size-start, buf, nil); err != nil {
+			return 0, err
+		}
+		// the trailing newline does not start a new line
+		data := bytes.TrimSuffix(buf.Bytes(), []byte{'\n'})
+		count := 0
+		for i := len(data) - 1; i >= 0; i-- {
+			if data[i] != '\n' {
+				continue
+			}
+			if count++; count == n {
+				return start + int64(i) + 1, nil
+			}
+		}
+		if start == 0 {
+			return 0, nil
+		}
+	}
+}
+
+func (f *FileSystem) dumpStreamFile(op string, args []string) {
+	var n int
+	if args, n, f.Error = intOption(args, OptionLines, DefaultLines); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	args, options := splitOptions(args, OptionFollow)
+	if len(args) == 0 {
+		util.ErrorBy(status.ErrPathEmpty)
+		return
+	}
+	file := f.concat(util.Trim(args[0]))
+
+	// stop streaming rather than quitting the program when Ctrl+C is pressed
+	ctx, stop := signal.NotifyContext(f.ctx, os.Interrupt)
+	defer stop()
+
+	pipe := filter.NewPipeWriter(f.Param.Node)
+	var writer io.Writer = pipe
+	var offset int64
+	switch op {
+	case internal.Head:
+		writer = &lineLimitWriter{writer: pipe, n: n}
+	case internal.Tail:
+		var fi *pb.FileInfo
+		if fi, f.Error = f.statFile(file); util.AssertErrorNotNil(f.Error) {
+			return
+		}
+		if offset, f.Error = f.tailOffset(file, fi.Size, n); util.AssertErrorNotNil(f.Error) {
+			return
+		}
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	f.Error = f.StreamFile(streamCtx, file, offset, op == internal.Tail && options[OptionFollow], writer)
+	// cancel the call if the stream is stopped early
+	cancel()
+	_ = pipe.Close()
+	if errors.Is(f.Error, errStopStream) || ctx.Err() != nil {
+		f.Error = nil
+	}
+	util.AssertErrorNotNil(f.Error)
+}
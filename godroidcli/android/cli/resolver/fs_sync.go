@@ -0,0 +1,352 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/progressbar"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	// OptionPull sync from the remote directory to the local directory
+	OptionPull = "--pull"
+	// OptionDelete delete the extraneous files from the destination directory
+	OptionDelete = "--delete"
+	// OptionDryRun only show the plan without applying it
+	OptionDryRun = "--dry-run"
+	// OptionHash always compare the digests of files with the same size, otherwise only when their modification times differ
+	OptionHash = "--hash"
+)
+
+const (
+	SyncMkDir    = "mkdir"
+	SyncCreate   = "create"
+	SyncUpdate   = "update"
+	SyncDelete   = "delete"
+	SyncConflict = "conflict"
+)
+
+type syncOptions struct {
+	pull   bool
+	delete bool
+	dryRun bool
+	hash   bool
+}
+
+type syncAction struct {
+	action string
+	// the relative path to the root directory, separated by "/"
+	rel   string
+	size  int64
+	mtime int64
+	dir   bool
+}
+
+// syncPlan the actions to make the destination directory the same as the source directory
+type syncPlan struct {
+	local   string
+	remote  string
+	pull    bool
+	actions []syncAction
+}
+
+func (p *syncPlan) localPath(rel string) string {
+	return filepath.Join(p.local, filepath.FromSlash(rel))
+}
+
+func (p *syncPlan) remotePath(rel string) string {
+	return path.Join(p.remote, rel)
+}
+
+// destPath the path of destination file or directory
+func (p *syncPlan) destPath(rel string) string {
+	if p.pull {
+		return p.localPath(rel)
+	}
+	return p.remotePath(rel)
+}
+
+func (p *syncPlan) count(actions ...string) (n int) {
+	for _, a := range p.actions {
+		for _, action := range actions {
+			if a.action == action {
+				n++
+			}
+		}
+	}
+	return
+}
+
+// listLocalEntries list the files and directories under local directory by the relative path
+func listLocalEntries(root string) (map[string]*pb.FileInfo, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, &os.PathError{Op: "sync", Path: root, Err: os.ErrInvalid}
+	}
+	files, dirs, err := walkLocalDir(root)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]*pb.FileInfo, len(files)+len(dirs))
+	for _, fi := range append(files, dirs...) {
+		rel, _ := filepath.Rel(root, fi.Name)
+		entries[filepath.ToSlash(rel)] = fi
+	}
+	return entries, nil
+}
+
+// listRemoteEntries list the files and directories under remote directory by the relative path
+func (f *FileSystem) listRemoteEntries(root string) (map[string]*pb.FileInfo, error) {
+	files, dirs, err := f.walkRemoteDir(root)
+	if err != nil {
+		// tell the missing directory apart from the other errors, such as timeout or permission denied
+		if _, statErr := f.statFile(root); errors.Is(statErr, os.ErrNotExist) {
+			return nil, statErr
+		}
+		return nil, err
+	}
+	entries := make(map[string]*pb.FileInfo, len(files)+len(dirs))
+	for _, fi := range append(files, dirs...) {
+		rel := strings.TrimPrefix(strings.TrimPrefix(fi.Name, root), "/")
+		// the trash directory of safe mode is never synced
+		if inTrashDir(rel) {
+			continue
+		}
+		entries[rel] = fi
+	}
+	return entries, nil
+}
+
+// changed check whether the destination file is different from the source file
+func (f *FileSystem) changed(plan *syncPlan, rel string, src, dest *pb.FileInfo, hash bool) bool {
+	if src.Size != dest.Size {
+		return true
+	}
+	// the precision of modification time is different between file systems
+	if !hash && src.LastModifiedTime/1000 == dest.LastModifiedTime/1000 {
+		return false
+	}
+	// the modification time is not kept by the storages which reject setting it,
+	// so the files of same size are compared by digests
+	local, err := hashLocalFile(plan.localPath(rel), HashSHA256)
+	if err != nil {
+		return true
+	}
+	remote, err := f.GetFileHash(plan.remotePath(rel), HashSHA256)
+	return err != nil || local != remote.Value
+}
+
+// PlanSync compare the source directory with the destination directory and make a plan,
+// the source is the local directory by default, or the remote directory if pull
+func (f *FileSystem) PlanSync(local, remote string, opts syncOptions) (*syncPlan, error) {
+	plan := &syncPlan{local: filepath.Clean(local), remote: f.concat(remote), pull: opts.pull}
+	localEntries, localErr := listLocalEntries(plan.local)
+	remoteEntries, remoteErr := f.listRemoteEntries(plan.remote)
+	src, dest, srcErr, destErr := localEntries, remoteEntries, localErr, remoteErr
+	if opts.pull {
+		src, dest, srcErr, destErr = remoteEntries, localEntries, remoteErr, localErr
+	}
+	if srcErr != nil {
+		return nil, srcErr
+	}
+	// the destination directory does not exist
+	if destErr != nil {
+		if !errors.Is(destErr, os.ErrNotExist) {
+			return nil, destErr
+		}
+		plan.actions = append(plan.actions, syncAction{action: SyncMkDir, dir: true})
+	}
+
+	names := make([]string, 0, len(src))
+	for rel := range src {
+		names = append(names, rel)
+	}
+	sort.Strings(names)
+	for _, rel := range names {
+		s := src[rel]
+		action := syncAction{rel: rel, size: s.Size, mtime: s.LastModifiedTime, dir: s.Dir}
+		d, ok := dest[rel]
+		switch {
+		case !ok && s.Dir:
+			action.action = SyncMkDir
+		case !ok:
+			action.action = SyncCreate
+		case s.Dir != d.Dir:
+			action.action = SyncConflict
+		case !s.Dir && f.changed(plan, rel, s, d, opts.hash):
+			action.action = SyncUpdate
+		default:
+			continue
+		}
+		plan.actions = append(plan.actions, action)
+	}
+
+	if opts.delete {
+		names = names[:0]
+		for rel := range dest {
+			if _, ok := src[rel]; !ok {
+				names = append(names, rel)
+			}
+		}
+		sort.Strings(names)
+		var deleted string
+		for _, rel := range names {
+			// the contents of deleted directory are deleted together
+			if deleted != "" && strings.HasPrefix(rel, deleted+"/") {
+				continue
+			}
+			d := dest[rel]
+			if d.Dir {
+				deleted = rel
+			}
+			plan.actions = append(plan.actions, syncAction{action: SyncDelete, rel: rel, size: d.Size, mtime: d.LastModifiedTime, dir: d.Dir})
+		}
+	}
+	return plan, nil
+}
+
+// ApplySync create the directories, transfer the changed files and delete the extraneous files
+func (f *FileSystem) ApplySync(plan *syncPlan) (failures []transferFailure) {
+	var tasks []transferTask
+	for _, a := range plan.actions {
+		var err error
+		switch a.action {
+		case SyncMkDir:
+			if plan.pull {
+				err = os.MkdirAll(plan.localPath(a.rel), 0755)
+			} else {
+				err = f.MkDir(plan.remotePath(a.rel))
+			}
+		case SyncCreate, SyncUpdate:
+			task := transferTask{src: plan.localPath(a.rel), dest: plan.remotePath(a.rel), size: a.size, mtime: a.mtime}
+			if plan.pull {
+				task.src, task.dest = task.dest, task.src
+			}
+			tasks = append(tasks, task)
+		}
+		if err != nil {
+			failures = append(failures, transferFailure{path: plan.destPath(a.rel), err: err})
+		}
+	}
+
+	progress := newTransferProgress(f.Progress(progressbar.New(plan.destPath("")).HideSavePath()), tasks)
+	if plan.pull {
+		failures = append(failures, runTransfers(tasks, f.downloadTask(progress, false))...)
+	} else {
+		failures = append(failures, runTransfers(tasks, f.uploadTask(progress, false))...)
+	}
+
+	for _, a := range plan.actions {
+		if a.action != SyncDelete {
+			continue
+		}
+		var err error
+		switch {
+		case plan.pull:
+			err = os.RemoveAll(plan.localPath(a.rel))
+		case a.dir:
+			err = f.remove(internal.RmDir, plan.remotePath(a.rel))
+		default:
+			err = f.remove(internal.Delete, plan.remotePath(a.rel))
+		}
+		if err != nil {
+			failures = append(failures, transferFailure{path: plan.destPath(a.rel), err: err})
+		}
+	}
+	if !plan.pull {
+		f.invalidateDirCache()
+	}
+	return
+}
+
+func (f *FileSystem) dumpSyncPlan(plan *syncPlan) {
+	table := pt.NewTable()
+	table.SetHeader(pt.Header{
+		util.Green("Action"),
+		util.Yellow("Path"),
+		util.Blue("Size"),
+		"LastModifiedTime",
+	})
+	rows := make([]map[string]interface{}, 0, len(plan.actions))
+	for _, a := range plan.actions {
+		action := util.Green(a.action)
+		switch a.action {
+		case SyncDelete, SyncConflict:
+			action = util.Red(a.action)
+		}
+		size := util.CalcFileBytes(a.size)
+		if a.dir {
+			size = "-"
+		}
+		table.AddRow(pt.Row{
+			action,
+			util.Yellow(plan.destPath(a.rel)),
+			util.Blue(size),
+			util.TimeOf(a.mtime),
+		})
+		rows = append(rows, map[string]interface{}{
+			"action":             a.action,
+			"path":               plan.destPath(a.rel),
+			"size":               a.size,
+			"dir":                a.dir,
+			"last_modified_time": a.mtime,
+		})
+	}
+	f.PrintRows(rows, table)
+}
+
+func (f *FileSystem) dumpSync(args []string, options map[string]bool) {
+	opts := syncOptions{
+		pull:   options[OptionPull],
+		delete: options[OptionDelete],
+		dryRun: options[OptionDryRun],
+		hash:   options[OptionHash],
+	}
+	local, remote := util.Trim(args[0]), util.Trim(args[1])
+	if opts.pull {
+		local, remote = remote, local
+	}
+	var plan *syncPlan
+	plan, f.Error = f.PlanSync(local, remote, opts)
+	if util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	if len(plan.actions) == 0 {
+		util.Info("already up to date: %s", util.HiRed(plan.destPath("")))
+		return
+	}
+	f.dumpSyncPlan(plan)
+	if opts.dryRun {
+		util.Info("dry run, nothing is changed")
+		return
+	}
+	failures := f.ApplySync(plan)
+	util.Info("sync %d files, %d directories, delete %d, skip %d conflicts",
+		plan.count(SyncCreate, SyncUpdate), plan.count(SyncMkDir), plan.count(SyncDelete), plan.count(SyncConflict))
+	f.dumpTransferFailures(failures)
+}
@@ -0,0 +1,732 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/josexy/godroidcli/android/cli/stream/streamtest"
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/status"
+)
+
+func newFakeFileSystem(t *testing.T, server *streamtest.FsServer) *FileSystem {
+	f := NewFileSystem(streamtest.Dial(t, server))
+	f.SetContext(NewResolverContext(context.Background(), f, nil, nil))
+	return f
+}
+
+func TestFileSystem_DownloadGeneralFile_Resume(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	server := &streamtest.FsServer{Data: data, AbortAfter: 400}
+	f := newFakeFileSystem(t, server)
+
+	dest := filepath.Join(t.TempDir(), "1.bin")
+	if err := f.DownloadGeneralFile("/sdcard/1.bin", dest); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("got %d bytes, want %d bytes", len(got), len(data))
+	}
+	if _, err = os.Stat(dest + PartFileSuffix); !os.IsNotExist(err) {
+		t.Fatalf("the partial file is not removed: %v", err)
+	}
+	if len(server.Offsets) != 2 || server.Offsets[0] != 0 || server.Offsets[1] != 400 {
+		t.Fatalf("got offsets %v, want [0 400]", server.Offsets)
+	}
+}
+
+func TestFileSystem_DownloadGeneralFile_PartFile(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	entry := &pb.FileInfo{Name: "/sdcard/1.bin", Size: int64(len(data)), LastModifiedTime: 1000}
+	tests := []struct {
+		name   string
+		part   []byte
+		meta   string
+		offset int64
+	}{
+		{"resume", data[:100], `{"size":1024,"mtime":1000}`, 100},
+		{"modified", data[:100], `{"size":1024,"mtime":500}`, 0},
+		{"resized", data[:100], `{"size":2048,"mtime":1000}`, 0},
+		{"no meta", data[:100], "", 0},
+		{"larger than remote", append(data, data...), `{"size":1024,"mtime":1000}`, 0},
+	}
+	for _, tt := range tests {
+		server := &streamtest.FsServer{Data: data, Dirs: map[string][]*pb.FileInfo{"/sdcard": {entry}}}
+		f := newFakeFileSystem(t, server)
+
+		dest := filepath.Join(t.TempDir(), "1.bin")
+		if err := os.WriteFile(dest+PartFileSuffix, tt.part, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if tt.meta != "" {
+			if err := os.WriteFile(dest+PartMetaSuffix, []byte(tt.meta), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := f.DownloadGeneralFile("/sdcard/1.bin", dest); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, err := os.ReadFile(dest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, data) {
+			t.Fatalf("%s: got %d bytes, want %d bytes", tt.name, len(got), len(data))
+		}
+		if len(server.Offsets) != 1 || server.Offsets[0] != tt.offset {
+			t.Fatalf("%s: got offsets %v, want [%d]", tt.name, server.Offsets, tt.offset)
+		}
+		if _, err = os.Stat(dest + PartMetaSuffix); !os.IsNotExist(err) {
+			t.Fatalf("%s: the meta file is not removed: %v", tt.name, err)
+		}
+	}
+}
+
+func TestFileSystem_DownloadDir_PartFile(t *testing.T) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 64)
+	server := &streamtest.FsServer{Data: data, Dirs: map[string][]*pb.FileInfo{
+		"/sdcard/app": {
+			{Name: "1.bin", Size: int64(len(data)), LastModifiedTime: 1000},
+		},
+	}}
+	f := newFakeFileSystem(t, server)
+
+	// the partial file of last recursive download is resumed
+	dest := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dest, "app"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dest, "app", "1.bin")
+	if err := os.WriteFile(file+PartFileSuffix, data[:100], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file+PartMetaSuffix, []byte(`{"size":1024,"mtime":1000}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	failures, err := f.DownloadDir("/sdcard/app", dest, false)
+	if err != nil || len(failures) != 0 {
+		t.Fatalf("got failures %v, %v", failures, err)
+	}
+	if got, _ := os.ReadFile(file); !bytes.Equal(got, data) {
+		t.Fatalf("got %d bytes, want %d bytes", len(got), len(data))
+	}
+	// the listing of walk is used to check the partial file
+	if len(server.Offsets) != 1 || server.Offsets[0] != 100 || server.Lists != 1 {
+		t.Fatalf("got offsets %v and %d listings, want [100] and 1", server.Offsets, server.Lists)
+	}
+
+	// the remote file is not stat without a partial file
+	if err = f.DownloadGeneralFile("/sdcard/app/1.bin", filepath.Join(dest, "2.bin")); err != nil {
+		t.Fatal(err)
+	}
+	if server.Lists != 1 {
+		t.Fatalf("got %d listings, want no stat", server.Lists)
+	}
+}
+
+func TestFileSystem_VerifyFile(t *testing.T) {
+	data := []byte("this is a text")
+	f := newFakeFileSystem(t, &streamtest.FsServer{Data: data})
+
+	local := filepath.Join(t.TempDir(), "1.txt")
+	if err := os.WriteFile(local, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.verifyFile(local, "/sdcard/1.txt"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(local, []byte("this is another text"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.verifyFile(local, "/sdcard/1.txt"); !errors.Is(err, status.ErrHashMismatch) {
+		t.Fatalf("got %v, want %v", err, status.ErrHashMismatch)
+	}
+}
+
+func TestFileSystem_UploadTask(t *testing.T) {
+	// the fake server rejects setting the modification time like sdcardfs
+	server := &streamtest.FsServer{}
+	f := newFakeFileSystem(t, server)
+	local := filepath.Join(t.TempDir(), "1.txt")
+	if err := os.WriteFile(local, []byte("this is a text"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	progress := newTransferProgress(func(present, total int64) {}, nil)
+	err := f.uploadTask(progress, true)(transferTask{src: local, dest: "/sdcard/1.txt", mtime: 1000})
+	if err != nil || string(server.Data) != "this is a text" || server.Hashes != 1 {
+		t.Fatalf("got %q, %d digests, %v, want the upload verified", server.Data, server.Hashes, err)
+	}
+}
+
+func TestFileSystem_PlanSync(t *testing.T) {
+	local := t.TempDir()
+	mtime := time.Date(2021, 10, 1, 0, 0, 0, 0, time.Local)
+	for name, text := range map[string]string{"same.txt": "same", "changed.txt": "changed", "new.txt": "new"} {
+		file := filepath.Join(local, name)
+		if err := os.WriteFile(file, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(file, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Mkdir(filepath.Join(local, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	f := newFakeFileSystem(t, &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{
+		// the locked directory exists but can not be listed
+		"/sdcard": {
+			{Name: "/sdcard/fixtures", Dir: true},
+			{Name: "/sdcard/locked", Dir: true},
+		},
+		"/sdcard/fixtures": {
+			{Name: "/sdcard/fixtures/same.txt", Size: 4, LastModifiedTime: mtime.UnixMilli()},
+			{Name: "/sdcard/fixtures/changed.txt", Size: 3, LastModifiedTime: mtime.UnixMilli()},
+			{Name: "/sdcard/fixtures/old", Dir: true},
+			// the trash directory of safe mode is ignored
+			{Name: "/sdcard/fixtures/.trash", Dir: true},
+		},
+		"/sdcard/fixtures/.trash": {},
+		"/sdcard/fixtures/old": {
+			{Name: "/sdcard/fixtures/old/1.txt", Size: 1},
+		},
+	}})
+
+	plan, err := f.PlanSync(local, "/sdcard/fixtures", syncOptions{delete: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"update changed.txt", "create new.txt", "mkdir sub", "delete old"}
+	var got []string
+	for _, a := range plan.actions {
+		got = append(got, a.action+" "+a.rel)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	// the remote directory does not exist when pushing
+	plan, err = f.PlanSync(local, "/sdcard/none", syncOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.actions) != 5 || plan.actions[0].action != SyncMkDir || plan.actions[0].rel != "" {
+		t.Fatalf("got %v, want mkdir the root directory first", plan.actions)
+	}
+	// the remote directory can not be listed
+	if _, err = f.PlanSync(local, "/sdcard/locked", syncOptions{delete: true}); err == nil {
+		t.Fatal("got nil error, want the listing error")
+	}
+}
+
+func TestFileSystem_SyncWithoutModTime(t *testing.T) {
+	local := t.TempDir()
+	if err := os.WriteFile(filepath.Join(local, "1.txt"), []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// the fake server rejects setting the modification time like /sdcard
+	server := &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{"/sdcard/fixtures": {}}}
+	f := newFakeFileSystem(t, server)
+	plan, err := f.PlanSync(local, "/sdcard/fixtures", syncOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if failures := f.ApplySync(plan); len(failures) != 0 || server.Uploads != 1 {
+		t.Fatalf("got failures %v, %d uploads", failures, server.Uploads)
+	}
+
+	// the uploaded file keeps the time of upload
+	uploaded := &pb.FileInfo{Name: "/sdcard/fixtures/1.txt", Size: 5, LastModifiedTime: time.Now().Add(time.Hour).UnixMilli()}
+	server.Dirs["/sdcard/fixtures"] = []*pb.FileInfo{uploaded}
+	if plan, err = f.PlanSync(local, "/sdcard/fixtures", syncOptions{}); err != nil || len(plan.actions) != 0 {
+		t.Fatalf("got %v, %v, want nothing to sync", plan, err)
+	}
+	if server.Hashes != 1 {
+		t.Fatalf("got %d digests, want the files compared by digests", server.Hashes)
+	}
+	// the file of same size is changed
+	server.Data = []byte("world")
+	if plan, err = f.PlanSync(local, "/sdcard/fixtures", syncOptions{}); err != nil ||
+		len(plan.actions) != 1 || plan.actions[0].action != SyncUpdate {
+		t.Fatalf("got %v, %v, want the file updated", plan, err)
+	}
+}
+
+func TestExpandGlob(t *testing.T) {
+	root := t.TempDir()
+	for _, name := range []string{"1.jpg", "2.jpg", "a.png", ".hidden.jpg", "sub/3.jpg", "sub/deep/4.jpg", "sub/deep/5.txt"} {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	base := filepath.ToSlash(root)
+	for pattern, want := range map[string][]string{
+		"*.jpg":         {"1.jpg", "2.jpg"},
+		"?.*":           {"1.jpg", "2.jpg", "a.png"},
+		"[0-9].jpg":     {"1.jpg", "2.jpg"},
+		".*.jpg":        {".hidden.jpg"},
+		"*/*.jpg":       {"sub/3.jpg"},
+		"**/*.jpg":      {"1.jpg", "2.jpg", "sub/3.jpg", "sub/deep/4.jpg"},
+		"sub/**":        {"sub/3.jpg", "sub/deep", "sub/deep/4.jpg", "sub/deep/5.txt"},
+		"sub/**/*.txt":  {"sub/deep/5.txt"},
+		"none/**/*.jpg": nil,
+	} {
+		matches, err := expandGlob(path.Join(base, pattern), listLocalDir)
+		if pattern == "none/**/*.jpg" {
+			if err == nil {
+				t.Fatalf("%s: got no error", pattern)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, fi := range matches {
+			got = append(got, strings.TrimPrefix(fi.Name, base+"/"))
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("%s: got %v, want %v", pattern, got, want)
+		}
+	}
+}
+
+func TestFileSystem_GlobOperandAborted(t *testing.T) {
+	var list []*pb.FileInfo
+	for i := 0; i <= MaxUnconfirmedMatches; i++ {
+		list = append(list, &pb.FileInfo{Name: fmt.Sprintf("/sdcard/%d.txt", i)})
+	}
+	server := &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{"/sdcard": list}}
+	f := newFakeFileSystem(t, server)
+
+	// the confirmation is declined when the stdin is closed
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = w.Close()
+	stdin := os.Stdin
+	os.Stdin = r
+	defer func() { os.Stdin = stdin }()
+
+	f.dumpGlobOperand(internal.Delete, []string{"/sdcard/*.txt"}, false)
+	if !errors.Is(f.Error, status.ErrAborted) || len(server.Ops) != 0 {
+		t.Fatalf("got %v, %v, want aborted", f.Error, server.Ops)
+	}
+}
+
+func TestFileSystem_GlobOperandFailed(t *testing.T) {
+	f := newFakeFileSystem(t, &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{"/sdcard": {
+		{Name: "/sdcard/1.txt"},
+		{Name: "/sdcard/2.txt"},
+	}}})
+	// the fake server does not implement deleting files
+	f.dumpGlobOperand(internal.Delete, []string{"/sdcard/*.txt"}, false)
+	if f.Error == nil || f.Error.Error() != "2 of 2 paths failed" {
+		t.Fatalf("got %v, want the failed paths", f.Error)
+	}
+}
+
+func TestFileSystem_Find(t *testing.T) {
+	now := time.Now()
+	days := func(n int) int64 { return now.Add(-time.Duration(n) * 24 * time.Hour).UnixMilli() }
+	f := newFakeFileSystem(t, &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{
+		"/sdcard": {
+			{Name: "/sdcard/app.log", Size: 20 << 20, LastModifiedTime: days(1)},
+			{Name: "/sdcard/old.log", Size: 20 << 20, LastModifiedTime: days(10)},
+			{Name: "/sdcard/small.log", Size: 1 << 10, LastModifiedTime: days(0)},
+			{Name: "/sdcard/logs", Dir: true, LastModifiedTime: days(0)},
+			// the protected directory can not be listed
+			{Name: "/sdcard/private", Dir: true, LastModifiedTime: days(10)},
+		},
+		"/sdcard/logs": {
+			{Name: "/sdcard/logs/1.log", Size: 11 << 20, LastModifiedTime: days(0)},
+			{Name: "/sdcard/logs/1.txt", Size: 11 << 20, LastModifiedTime: days(0)},
+		},
+	}})
+
+	for expr, want := range map[string][]string{
+		"-name *.log -size +10M -mtime -2 -type f": {"/sdcard/app.log", "/sdcard/logs/1.log"},
+		"-name *.log -size -1M":                    {"/sdcard/small.log"},
+		"-mtime +5 -type f":                        {"/sdcard/old.log"},
+		"-type d":                                  {"/sdcard/logs", "/sdcard/private"},
+	} {
+		predicates, err := parseFindPredicates(strings.Fields(expr))
+		if err != nil {
+			t.Fatal(err)
+		}
+		matches, skipped, err := f.Find("/sdcard", predicates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(skipped) != 1 || skipped[0].path != "/sdcard/private" {
+			t.Fatalf("%s: got skipped %v, want /sdcard/private", expr, skipped)
+		}
+		var got []string
+		for _, fi := range matches {
+			got = append(got, fi.Name)
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Fatalf("%s: got %v, want %v", expr, got, want)
+		}
+	}
+
+	for _, expr := range []string{"-size 10X", "-type x", "-name", "-owner root"} {
+		if _, err := parseFindPredicates(strings.Fields(expr)); err == nil {
+			t.Fatalf("%s: got no error", expr)
+		}
+	}
+}
+
+func TestFileSystem_DiskUsage(t *testing.T) {
+	f := newFakeFileSystem(t, &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{
+		"/sdcard": {
+			{Name: "/sdcard/1.bin", Size: 10},
+			{Name: "/sdcard/DCIM", Dir: true},
+			{Name: "/sdcard/Music", Dir: true},
+			// the protected directory can not be listed
+			{Name: "/sdcard/Android", Dir: true},
+		},
+		"/sdcard/DCIM": {
+			{Name: "/sdcard/DCIM/1.jpg", Size: 100},
+			{Name: "/sdcard/DCIM/Camera", Dir: true},
+		},
+		"/sdcard/DCIM/Camera": {
+			{Name: "/sdcard/DCIM/Camera/2.jpg", Size: 1000},
+		},
+		"/sdcard/Music": {
+			{Name: "/sdcard/Music/1.mp3", Size: 500},
+		},
+	}})
+
+	tree, skipped, err := f.DiskUsage("/sdcard/")
+	if err != nil || len(skipped) != 1 || skipped[0].path != "/sdcard/Android" {
+		t.Fatalf("got skipped %v, %v, want /sdcard/Android", skipped, err)
+	}
+	var got []string
+	tree.flatten(0, 1, func(node *duNode, level int) {
+		got = append(got, fmt.Sprintf("%d:%s:%d:%d", level, node.name, node.size, node.files))
+	})
+	want := []string{"0:/sdcard:1610:4", "1:/sdcard/DCIM:1100:2", "1:/sdcard/Music:500:1", "1:/sdcard/Android:0:0"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	files, skipped, err := f.TopFiles("/sdcard", 2)
+	if err != nil || len(skipped) != 1 {
+		t.Fatalf("got skipped %v, %v", skipped, err)
+	}
+	if len(files) != 2 || files[0].Name != "/sdcard/DCIM/Camera/2.jpg" || files[1].Name != "/sdcard/Music/1.mp3" {
+		t.Fatalf("got %v, want the two largest files", files)
+	}
+}
+
+func TestFileSystem_StreamFile(t *testing.T) {
+	var lines []string
+	for i := 1; i <= 100; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	data := []byte(strings.Join(lines, "\n") + "\n")
+	f := newFakeFileSystem(t, &streamtest.FsServer{Data: data})
+
+	buf := bytes.NewBuffer(nil)
+	err := f.StreamFile(context.Background(), "/sdcard/app.log", 0, false, &lineLimitWriter{writer: buf, n: 3})
+	if !errors.Is(err, errStopStream) || buf.String() != "line 1\nline 2\nline 3\n" {
+		t.Fatalf("got %q, %v", buf.String(), err)
+	}
+
+	for n, want := range map[int]string{0: "", 2: "line 99\nline 100\n", 200: string(data)} {
+		offset, err := f.tailOffset("/sdcard/app.log", int64(len(data)), n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(data[offset:]); got != want {
+			t.Fatalf("tail -n %d: got %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestFileSystem_EditFile(t *testing.T) {
+	entry := &pb.FileInfo{Name: "/sdcard/config.json", Size: 2, LastModifiedTime: 1000}
+	server := &streamtest.FsServer{Data: []byte("{}"), Dirs: map[string][]*pb.FileInfo{"/sdcard": {entry}}}
+	f := newFakeFileSystem(t, server)
+
+	changed, _, err := f.EditFile("/sdcard/config.json", func(string) error { return nil })
+	if err != nil || changed || server.Uploads != 0 {
+		t.Fatalf("got changed %v, %d uploads, %v, want nothing uploaded", changed, server.Uploads, err)
+	}
+
+	changed, _, err = f.EditFile("/sdcard/config.json", func(local string) error {
+		if filepath.Base(local) != "config.json" {
+			t.Fatalf("got temporary file %s, want the same base name", local)
+		}
+		return os.WriteFile(local, []byte(`{"a": 1}`), 0644)
+	})
+	if err != nil || !changed || string(server.Data) != `{"a": 1}` {
+		t.Fatalf("got changed %v, %q, %v", changed, server.Data, err)
+	}
+
+	// the remote file is modified while editing
+	changed, kept, err := f.EditFile("/sdcard/config.json", func(local string) error {
+		entry.LastModifiedTime = 2000
+		return os.WriteFile(local, []byte(`{"b": 2}`), 0644)
+	})
+	if !errors.Is(err, status.ErrRemoteModified) || server.Uploads != 1 {
+		t.Fatalf("got %d uploads, %v, want %v", server.Uploads, err, status.ErrRemoteModified)
+	}
+	if data, _ := os.ReadFile(kept); !changed || string(data) != `{"b": 2}` {
+		t.Fatalf("got %q in %s, want the changes kept", data, kept)
+	}
+	_ = os.RemoveAll(filepath.Dir(kept))
+}
+
+func TestFileSystem_TrashAndUndo(t *testing.T) {
+	server := &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{"/sdcard": {}}}
+	f := newFakeFileSystem(t, server)
+	f.SetSafeMode(true, "/sdcard/.trash")
+	trash := path.Join("/sdcard/.trash", f.trash.session)
+	// the file outside the shared storage is moved into the trash beside it
+	tmpTrash := path.Join("/data/local/tmp/.trash", f.trash.session)
+
+	f.doOperand(internal.RmDir, "/sdcard/data")
+	f.doOperand(internal.Rename, "/sdcard/a", "/sdcard/b")
+	f.doOperand(internal.Move, "/sdcard/b", "/sdcard/c")
+	if _, err := f.RestoreTrash(1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.RestoreTrash(1); !errors.Is(err, status.ErrTrashNotFound) {
+		t.Fatalf("got %v, want %v", err, status.ErrTrashNotFound)
+	}
+	if _, err := f.Undo(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Undo(); !errors.Is(err, status.ErrNothingToUndo) {
+		t.Fatalf("got %v, want %v", err, status.ErrNothingToUndo)
+	}
+	f.doOperand(internal.Delete, "/storage/emulated/0/a.txt")
+	f.doOperand(internal.Delete, "/data/local/tmp/b.txt")
+	// the extraneous files of sync are deleted in safe mode as well
+	f.ApplySync(&syncPlan{remote: "/sdcard/sync", actions: []syncAction{{action: SyncDelete, rel: "old.txt"}}})
+	if n, err := f.EmptyTrash(); err != nil || n != 3 {
+		t.Fatalf("got %d, %v, want 3 entries removed", n, err)
+	}
+	want := []string{
+		"mkdir " + trash + "/1",
+		"move /sdcard/data " + trash + "/1/data",
+		"rename /sdcard/a /sdcard/b",
+		"move /sdcard/b /sdcard/c",
+		"move " + trash + "/1/data /sdcard/data",
+		"rmdir " + trash + "/1",
+		// only the move of last command is reverted
+		"move /sdcard/c /sdcard/b",
+		"mkdir " + trash + "/2",
+		"move /storage/emulated/0/a.txt " + trash + "/2/a.txt",
+		"mkdir " + tmpTrash + "/3",
+		"move /data/local/tmp/b.txt " + tmpTrash + "/3/b.txt",
+		"mkdir " + trash + "/4",
+		"move /sdcard/sync/old.txt " + trash + "/4/old.txt",
+		"rmdir " + trash,
+		"rmdir " + tmpTrash,
+	}
+	if got := strings.Join(server.Ops, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("got operations:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+}
+
+func TestFileSystem_WriteArchive(t *testing.T) {
+	server := &streamtest.FsServer{Data: []byte("hello world"), Dirs: map[string][]*pb.FileInfo{
+		"/sdcard/app": {
+			{Name: "files", Dir: true, LastModifiedTime: 1000},
+			{Name: "log.txt", Size: 11, LastModifiedTime: 2000},
+			// the protected directory can not be listed
+			{Name: "private", Dir: true},
+		},
+		"/sdcard/app/files": {
+			{Name: "cache", Dir: true},
+			// the file is truncated after listing
+			{Name: "b.txt", Size: 16},
+		},
+		"/sdcard/app/files/cache": {},
+	}}
+	f := newFakeFileSystem(t, server)
+	// the truncated file is padded and explained by the note entry
+	note := "padded with 5 NUL bytes after 11 of 16 bytes: unexpected EOF\n"
+	want := map[string]string{
+		"app/files/":             "",
+		"app/files/b.txt":        "hello world\x00\x00\x00\x00\x00",
+		"app/files/b.txt.FAILED": note,
+		"app/files/cache/":       "",
+		"app/log.txt":            "hello world",
+		"app/private/":           "",
+		"app/private.FAILED":     "rpc error: code = Unknown desc = can not list directory\n",
+	}
+
+	var buf bytes.Buffer
+	failures, err := f.WriteArchive("/sdcard/app", internal.TarGz, &buf, nil)
+	if err != nil || len(failures) != 2 || failures[0].path != "/sdcard/app/files/b.txt" ||
+		failures[1].path != "/sdcard/app/private" {
+		t.Fatalf("got failures %v, %v", failures, err)
+	}
+	if err = f.Archive("/sdcard/app", internal.TarGz, io.Discard, nil); err == nil ||
+		!strings.Contains(err.Error(), "/sdcard/app/files/b.txt") {
+		t.Fatalf("got %v, want the incomplete file is reported", err)
+	}
+	gz, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(tr)
+		got[hdr.Name] = string(data)
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("tar.gz: got %q, want %q", got, want)
+	}
+
+	buf.Reset()
+	if failures, err = f.WriteArchive("/sdcard/app", internal.Zip, &buf, nil); err != nil || len(failures) != 2 {
+		t.Fatalf("got failures %v, %v", failures, err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = map[string]string{}
+	for _, file := range zr.File {
+		rc, _ := file.Open()
+		data, _ := io.ReadAll(rc)
+		_ = rc.Close()
+		got[file.Name] = string(data)
+	}
+	want["app/files/b.txt"] = "hello world"
+	want["app/files/b.txt.FAILED"] = "truncated after 11 of 16 bytes: unexpected EOF\n"
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("zip: got %q, want %q", got, want)
+	}
+}
+
+func TestIsRemotePathArg(t *testing.T) {
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"download", true},
+		{"download -r", true},
+		{"download --verify", true},
+		{"download -r /sdcard/DCIM", false},
+		{"upload ./x", true},
+		{"upload -r ./x", true},
+		{"upload ./x /sdcard", false},
+		{"tail -f", true},
+		{"head -n 5", true},
+		// the value of option is being typed
+		{"head -n", false},
+		{"du --depth 2", true},
+		{"sync ./x", true},
+		{"sync --pull", true},
+		{"sync --pull /sdcard/x", false},
+		{"delete --yes", true},
+	}
+	for _, tt := range tests {
+		fields := strings.Fields(tt.line)
+		if got := IsRemotePathArg(fields[0], fields[1:]); got != tt.want {
+			t.Errorf("IsRemotePathArg(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
+
+func TestFileSystem_CompletePath(t *testing.T) {
+	server := &streamtest.FsServer{Dirs: map[string][]*pb.FileInfo{
+		"/sdcard": {
+			{Name: "/sdcard/Download", Dir: true},
+			{Name: "/sdcard/DCIM", Dir: true},
+			{Name: "/sdcard/a.txt"},
+		},
+		"/sdcard/Download": {
+			{Name: "/sdcard/Download/1.apk"},
+		},
+	}}
+	f := newFakeFileSystem(t, server)
+	f.SetRemoteDir("/sdcard")
+	complete := func(word string) string {
+		return strings.Join(f.CompletePath(word), ",")
+	}
+
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"D", "Download/,DCIM/"},
+		{"Download/", "Download/1.apk"},
+		{"/sdcard/a", "/sdcard/a.txt"},
+		{"x", ""},
+		// the directory which can not be listed
+		{"/data/", ""},
+	}
+	for _, tt := range tests {
+		if got := complete(tt.word); got != tt.want {
+			t.Fatalf("%q: got %q, want %q", tt.word, got, tt.want)
+		}
+	}
+
+	// the cached entries are returned until they are expired or invalidated
+	server.Dirs["/sdcard"] = append(server.Dirs["/sdcard"], &pb.FileInfo{Name: "/sdcard/b.txt"})
+	if got := complete("b"); got != "" {
+		t.Fatalf("got %q, want the cached entries", got)
+	}
+	f.doOperand(internal.Create, "b.txt")
+	if got := complete("b"); got != "b.txt" {
+		t.Fatalf("got %q, want b.txt after the cache is invalidated", got)
+	}
+
+	server.Dirs["/sdcard/Download"] = nil
+	entry := f.dirCache["/sdcard/Download"]
+	entry.expire = time.Now().Add(-time.Second)
+	f.dirCache["/sdcard/Download"] = entry
+	if got := complete("Download/"); got != "" {
+		t.Fatalf("got %q, want the expired entries listed again", got)
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"errors"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+)
+
+const (
+	TrashOn      = "on"
+	TrashOff     = "off"
+	TrashList    = "list"
+	TrashRestore = "restore"
+	TrashEmpty   = "empty"
+	// DefaultTrashDir the directory on device where the deleted paths are moved to in safe mode
+	DefaultTrashDir = "/sdcard/.trash"
+	// TrashDirName the trash directory created beside the deleted path which is not on the shared storage
+	TrashDirName = ".trash"
+)
+
+// the paths of shared storage, which are on the same filesystem
+var sharedStorages = []string{"/sdcard", "/storage/emulated/0", "/storage/self/primary", "/mnt/sdcard"}
+
+// trashEntry a path which has been moved into the trash
+type trashEntry struct {
+	ID        int
+	Path      string
+	TrashPath string
+	Time      time.Time
+}
+
+// trashBin the paths deleted in safe mode, every session has its own directory under the trash directory.
+// the entries are only kept in memory, so the directories of previous sessions are left on device.
+// it is shared with the background jobs, so the fields except root and session are guarded by mu
+type trashBin struct {
+	mu      sync.Mutex
+	safe    bool
+	root    string
+	session string
+	lastID  int
+	entries []*trashEntry
+}
+
+func newTrashBin(safe bool, root string) *trashBin {
+	if root == "" {
+		root = DefaultTrashDir
+	}
+	return &trashBin{safe: safe, root: root, session: time.Now().Format("20060102-150405")}
+}
+
+// rootOf the trash directory on the same filesystem as the file, since the file is moved atomically
+// and can not be moved across filesystems. the file outside the filesystem of configured trash
+// directory is moved into the trash directory beside it
+func (t *trashBin) rootOf(file string) string {
+	if hasPathPrefix(file, path.Dir(t.root)) || (onSharedStorage(t.root) && onSharedStorage(file)) {
+		return t.root
+	}
+	return path.Join(path.Dir(file), TrashDirName)
+}
+
+func (t *trashBin) isSafe() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.safe
+}
+
+func (t *trashBin) setSafe(safe bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.safe = safe
+}
+
+// list the copy of entries
+func (t *trashBin) list() []*trashEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*trashEntry(nil), t.entries...)
+}
+
+func hasPathPrefix(file, dir string) bool {
+	return dir == "/" || file == dir || strings.HasPrefix(file, dir+"/")
+}
+
+// inTrashDir check whether the path is in the trash directory beside the deleted paths
+func inTrashDir(file string) bool {
+	for _, name := range strings.Split(file, "/") {
+		if name == TrashDirName {
+			return true
+		}
+	}
+	return false
+}
+
+func onSharedStorage(file string) bool {
+	for _, storage := range sharedStorages {
+		if hasPathPrefix(file, storage) {
+			return true
+		}
+	}
+	return false
+}
+
+// movePair a rename or move which can be reverted by undo
+type movePair struct {
+	op   string
+	src  string
+	dest string
+}
+
+// SetSafeMode move the deleted paths into the trash directory instead of removing them if safe
+func (f *FileSystem) SetSafeMode(safe bool, trashDir string) {
+	f.trash = newTrashBin(safe, trashDir)
+}
+
+// Trash move the file or directory into the trash of session
+func (f *FileSystem) Trash(file string) (*trashEntry, error) {
+	f.trash.mu.Lock()
+	defer f.trash.mu.Unlock()
+	id := f.trash.lastID + 1
+	dir := path.Join(f.trash.rootOf(file), f.trash.session, util.IntToStr(id))
+	if err := f.MkDir(dir); err != nil {
+		return nil, err
+	}
+	entry := &trashEntry{ID: id, Path: file, TrashPath: path.Join(dir, path.Base(file)), Time: time.Now()}
+	if err := f.Move(file, entry.TrashPath); err != nil {
+		_ = f.RmDir(dir)
+		return nil, err
+	}
+	f.trash.lastID = id
+	f.trash.entries = append(f.trash.entries, entry)
+	return entry, nil
+}
+
+// remove delete the file or directory (rmdir), which is moved into the trash in safe mode
+func (f *FileSystem) remove(op, file string) error {
+	if f.trash.isSafe() {
+		entry, err := f.Trash(file)
+		if err == nil {
+			util.Info("move to trash [%d]: %s", entry.ID, file)
+		}
+		return err
+	}
+	if op == internal.RmDir {
+		return f.RmDir(file)
+	}
+	return f.DeleteFile(file)
+}
+
+// RestoreTrash move the entry back to its original path, the existing path is never overwritten
+func (f *FileSystem) RestoreTrash(id int) (*trashEntry, error) {
+	f.trash.mu.Lock()
+	defer f.trash.mu.Unlock()
+	index := -1
+	for i, entry := range f.trash.entries {
+		if entry.ID == id {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, status.ErrTrashNotFound
+	}
+	entry := f.trash.entries[index]
+	_, err := f.statFile(entry.Path)
+	if err == nil {
+		return nil, status.ErrPathExists
+	} else if !errors.Is(err, os.ErrNotExist) {
+		// the parent directory may have been removed as well
+		if err = f.MkDir(path.Dir(entry.Path)); err != nil {
+			return nil, err
+		}
+	}
+	if err = f.Move(entry.TrashPath, entry.Path); err != nil {
+		return nil, err
+	}
+	_ = f.RmDir(path.Dir(entry.TrashPath))
+	f.trash.entries = append(f.trash.entries[:index], f.trash.entries[index+1:]...)
+	return entry, nil
+}
+
+// EmptyTrash remove the trash directories of session permanently and return the number of removed entries
+func (f *FileSystem) EmptyTrash() (int, error) {
+	f.trash.mu.Lock()
+	defer f.trash.mu.Unlock()
+	n := len(f.trash.entries)
+	if n == 0 {
+		return 0, nil
+	}
+	removed := make(map[string]bool)
+	for _, entry := range f.trash.entries {
+		// root/session/id/name
+		dir := path.Dir(path.Dir(entry.TrashPath))
+		if removed[dir] {
+			continue
+		}
+		if err := f.RmDir(dir); err != nil {
+			return 0, err
+		}
+		removed[dir] = true
+	}
+	f.trash.entries = nil
+	return n, nil
+}
+
+// recordMoves remember the renames or moves of the last command for undo
+func (f *FileSystem) recordMoves(moves []movePair) {
+	if len(moves) > 0 {
+		f.lastMoves = moves
+	}
+}
+
+// Undo revert the renames or moves of the last command in reverse order
+func (f *FileSystem) Undo() ([]movePair, error) {
+	moves := f.lastMoves
+	if len(moves) == 0 {
+		return nil, status.ErrNothingToUndo
+	}
+	for i := len(moves) - 1; i >= 0; i-- {
+		m := moves[i]
+		var err error
+		if m.op == internal.Rename {
+			err = f.Rename(m.dest, m.src)
+		} else {
+			err = f.Move(m.dest, m.src)
+		}
+		if err != nil {
+			// keep the moves which have not been reverted
+			f.lastMoves = moves[:i+1]
+			return nil, err
+		}
+	}
+	f.lastMoves = nil
+	return moves, nil
+}
+
+func (f *FileSystem) dumpTrash(args []string) {
+	op := TrashList
+	if len(args) > 0 {
+		op = util.Trim(args[0])
+	}
+	switch op {
+	case TrashOn, TrashOff:
+		f.trash.setSafe(op == TrashOn)
+		util.Info("safe mode: %s, trash directory: %s", op, f.trash.root)
+	case TrashList:
+		table := pt.NewTable()
+		table.SetHeader(pt.Header{util.Green("ID"), util.Yellow("Path"), util.Blue("DeletedTime"), "TrashPath"})
+		entries := f.trash.list()
+		rows := make([]map[string]interface{}, 0, len(entries))
+		for _, entry := range entries {
+			deleted := entry.Time.Format("2006-01-02 15:04:05")
+			table.AddRow(pt.Row{
+				util.Green(util.IntToStr(entry.ID)),
+				util.Yellow(entry.Path),
+				util.Blue(deleted),
+				entry.TrashPath,
+			})
+			rows = append(rows, map[string]interface{}{
+				"id":           entry.ID,
+				"path":         entry.Path,
+				"deleted_time": deleted,
+				"trash_path":   entry.TrashPath,
+			})
+		}
+		f.PrintRows(rows, table)
+	case TrashRestore:
+		if len(args) < 2 {
+			util.ErrorBy(status.ErrProvideParams)
+			return
+		}
+		var id int
+		if id, f.Error = util.StrToInt(util.Trim(args[1])); util.AssertErrorNotNil(f.Error) {
+			return
+		}
+		var entry *trashEntry
+		if entry, f.Error = f.RestoreTrash(id); util.AssertErrorNotNil(f.Error) {
+			return
+		}
+		f.invalidateDirCache()
+		util.Info("restore successfully: %s", util.HiGreen(entry.Path))
+	case TrashEmpty:
+		_, options := splitOptions(args[1:], OptionYes)
+		if n := len(f.trash.list()); n > 0 && !options[OptionYes] &&
+			!confirm("permanently remove %d paths in the trash?", n) {
+			f.Error = status.ErrAborted
+			util.ErrorBy(f.Error)
+			return
+		}
+		var n int
+		if n, f.Error = f.EmptyTrash(); util.AssertErrorNotNil(f.Error) {
+			return
+		}
+		util.Info("remove %d paths in the trash", n)
+	default:
+		util.ErrorBy(status.ErrorIllegalOperation)
+	}
+}
+
+func (f *FileSystem) dumpUndo() {
+	var moves []movePair
+	if moves, f.Error = f.Undo(); util.AssertErrorNotNil(f.Error) {
+		return
+	}
+	f.invalidateDirCache()
+	for _, m := range moves {
+		util.Info("%s back: %s -> %s", m.op, m.dest, m.src)
+	}
+}
@@ -98,10 +98,7 @@ func (m *MediaStore) dumpThumbnail(uri, filename string) {
 	}
 	defer fp.Close()
 
-	pb := progressbar.New(filepath.Base(filename))
-	m.Error = m.GetMediaFileThumbnail(uri, fp, func(present, total int64) {
-		pb.Update(present, total)
-	})
+	m.Error = m.GetMediaFileThumbnail(uri, fp, m.Progress(progressbar.New(filepath.Base(filename))))
 	if util.AssertErrorNotNil(m.Error) {
 		return
 	}
@@ -117,10 +114,7 @@ func (m *MediaStore) dumpDownloadMediaFile(uri, filename string) {
 	}
 	defer fp.Close()
 
-	pb := progressbar.New(filepath.Base(filename))
-	m.Error = m.DownloadMediaFile(uri, fp, func(present, total int64) {
-		pb.Update(present, total)
-	})
+	m.Error = m.DownloadMediaFile(uri, fp, m.Progress(progressbar.New(filepath.Base(filename))))
 	if util.AssertErrorNotNil(m.Error) {
 		return
 	}
@@ -156,7 +150,7 @@ func (m *MediaStore) dumpMediaFilesInfo(typ string) {
 			util.Cyan(info.Uri),
 		})
 	}
-	table.Filter(m.Param.Node).Print()
+	m.PrintResult(list, table)
 }
 
 // Run
@@ -118,7 +118,7 @@ func (n *Network) dumpNetworkInfo() {
 			})
 		}
 	}
-	table.Filter(n.Param.Node).Print()
+	n.PrintResult(list, table)
 }
 
 func (n *Network) dumpWifiInfo() {
@@ -148,7 +148,7 @@ func (n *Network) dumpWifiInfo() {
 	fn("TxLinkSpeed", util.Int32ToStr(wi.TxSpeed)+"Mbps")
 	fn("RxLinkSpeed", util.Int32ToStr(wi.RxSpeed)+"Mbps")
 	fn("Status", wi.Status)
-	table.Filter(n.Param.Node).Print()
+	n.PrintResult(wi, table)
 }
 
 func (n *Network) dumpScanWifiList() {
@@ -172,7 +172,7 @@ func (n *Network) dumpScanWifiList() {
 			util.Red(wi.Signal),
 		})
 	}
-	table.Filter(n.Param.Node).Print()
+	n.PrintResult(list, table)
 }
 
 func (n *Network) dumpNetworkConnectivity() {
@@ -228,7 +228,7 @@ func (n *Network) dumpAllActiveNetworkInfo() {
 		})
 	}
 
-	table.Filter(n.Param.Node).Print()
+	n.PrintResult(list, table)
 }
 
 func (n *Network) dumpPublicNetworkInfo() {
@@ -251,7 +251,7 @@ func (n *Network) dumpPublicNetworkInfo() {
 	fn("Timezone", pni.Timezone)
 	fn("Hostname", pni.Hostname)
 
-	table.Filter(n.Param.Node).Print()
+	n.PrintResult(pni, table)
 }
 
 // Run
@@ -0,0 +1,103 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package resolver
+
+import (
+	"encoding/json"
+
+	"github.com/josexy/godroidcli/android/api/wrapper"
+	"github.com/josexy/godroidcli/filter"
+	pt "github.com/josexy/godroidcli/prettytable"
+	"github.com/josexy/godroidcli/util"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	OutputTable = "table"
+	OutputJSON  = filter.Json
+	OutputYAML  = filter.Yaml
+)
+
+// Result the output of resolver command
+type Result struct {
+	Message proto.Message
+	Table   *pt.PrettyTable
+}
+
+func IsOutputFormat(format string) bool {
+	return format == OutputTable || format == OutputJSON || format == OutputYAML
+}
+
+// OutputFormat the pipe stage "| json" or "| yaml" takes precedence over the output option
+func OutputFormat(node *filter.Node, output string) string {
+	if format := node.Format(); format != "" {
+		return format
+	}
+	if output == "" {
+		return OutputTable
+	}
+	return output
+}
+
+// MarshalOutput convert the proto message into a generic object
+func MarshalOutput(msg proto.Message) (interface{}, error) {
+	return wrapper.MarshalToBytes(msg)
+}
+
+// EncodeOutput encode the object as JSON or YAML
+func EncodeOutput(obj interface{}, format string) ([]byte, error) {
+	if format == OutputYAML {
+		return yaml.Marshal(obj)
+	}
+	return json.MarshalIndent(obj, "", "  ")
+}
+
+// PrintResult print the raw proto message as JSON or YAML if the output format is specified,
+// otherwise filter and print the table. the result is collected when broadcasting command
+func (ctx *ResolverContext) PrintResult(msg proto.Message, table *pt.PrettyTable) {
+	if ctx.collect {
+		ctx.results = append(ctx.results, Result{Message: msg, Table: table})
+		return
+	}
+	format := OutputFormat(ctx.Param.Node, ctx.Output)
+	if format == OutputTable {
+		table.Filter(ctx.Param.Node).Print()
+		return
+	}
+	obj, err := MarshalOutput(msg)
+	if util.AssertErrorNotNil(err) {
+		return
+	}
+	data, err := EncodeOutput(obj, format)
+	if util.AssertErrorNotNil(err) {
+		return
+	}
+	filter.PipeOutput(data, ctx.Param.Node)
+}
+
+// PrintRows print the rows which have no proto message like PrintResult, they are output as a list of objects
+func (ctx *ResolverContext) PrintRows(rows []map[string]interface{}, table *pt.PrettyTable) {
+	values := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, row)
+	}
+	list, err := structpb.NewList(values)
+	if util.AssertErrorNotNil(err) {
+		return
+	}
+	ctx.PrintResult(list, table)
+}
@@ -198,7 +198,7 @@ func (p *PackageManager) dumpAllPackageInfo(typ string) {
 			util.Red(util.BoolToStr(pi.SystemApp)),
 		})
 	}
-	table.Filter(p.Param.Node).Print()
+	p.PrintResult(list, table)
 }
 
 func (p *PackageManager) dumpPackageInfo(packageName string) {
@@ -224,7 +224,7 @@ func (p *PackageManager) dumpPackageInfo(packageName string) {
 	fn("SourceDir", pi.ApplicationInfo.SourceDir)
 	fn("MinSDKVersion", util.Int32ToStr(pi.ApplicationInfo.MinSdkVersion))
 	fn("TargetSDKVersion", util.Int32ToStr(pi.ApplicationInfo.TargetSdkVersion))
-	table.Filter(p.Param.Node).Print()
+	p.PrintResult(pi, table)
 }
 
 func (p *PackageManager) dumpApplicationInfo(packageName string) {
@@ -244,7 +244,7 @@ func (p *PackageManager) dumpApplicationInfo(packageName string) {
 	fn("SourceDir", ai.SourceDir)
 	fn("MinSDKVersion", util.Int32ToStr(ai.MinSdkVersion))
 	fn("TargetSDKVersion", util.Int32ToStr(ai.TargetSdkVersion))
-	table.Filter(p.Param.Node).Print()
+	p.PrintResult(ai, table)
 }
 
 func (p *PackageManager) dumpAppSize(packageName string) {
@@ -266,7 +266,7 @@ func (p *PackageManager) dumpAppSize(packageName string) {
 		util.Blue(util.CalcCommonBytes(as.DataBytes)),
 		util.Red(util.CalcCommonBytes(as.TotalBytes)),
 	})
-	table.Filter(p.Param.Node).Print()
+	p.PrintResult(as, table)
 }
 
 func (p *PackageManager) dumpInstall(apkFile string) {
@@ -295,10 +295,7 @@ func (p *PackageManager) dumpGetApkFile(packageName, fileName string) {
 	}
 	defer func() { _ = fp.Close() }()
 
-	pb := progressbar.New(filepath.Base(fileName))
-	p.Error = p.GetApkFile(packageName, fp, func(present, total int64) {
-		pb.Update(present, total)
-	})
+	p.Error = p.GetApkFile(packageName, fp, p.Progress(progressbar.New(filepath.Base(fileName))))
 	util.AssertErrorNotNil(p.Error)
 }
 
@@ -308,10 +305,7 @@ func (p *PackageManager) dumpGetAppIcon(packageName, fileName string) {
 	}
 	buf := bytes.NewBuffer(nil)
 
-	pb := progressbar.New(filepath.Base(fileName))
-	p.Error = p.GetAppIcon(packageName, buf, func(present, total int64) {
-		pb.Update(present, total)
-	})
+	p.Error = p.GetAppIcon(packageName, buf, p.Progress(progressbar.New(filepath.Base(fileName))))
 	if util.AssertErrorNotNil(p.Error) {
 		return
 	}
@@ -327,7 +321,7 @@ func (p *PackageManager) dumpList(list *pb.StringList, err error) {
 	for _, s := range list.Values {
 		table.AddRow(pt.Row{s})
 	}
-	table.Filter(p.Param.Node).Print()
+	p.PrintResult(list, table)
 }
 
 func (p *PackageManager) dumpGetPermissions(packageName string) {
@@ -17,7 +17,9 @@ package resolver
 import (
 	"context"
 
+	"github.com/josexy/godroidcli/android/cli/stream"
 	"github.com/josexy/godroidcli/filter"
+	"github.com/josexy/godroidcli/progressbar"
 	"github.com/josexy/godroidcli/status"
 )
 
@@ -37,6 +39,13 @@ type Resolver interface {
 	Run(filter.Param) bool
 }
 
+// StatefulResolver is implemented by the resolvers which hold some state,
+// the state is copied to the new resolver when the session is reconnected
+type StatefulResolver interface {
+	Resolver
+	CopyState(Resolver)
+}
+
 type AuxResolver interface {
 	GetResolver(name string) Resolver
 }
@@ -46,6 +55,13 @@ type ResolverContext struct {
 	Param filter.Param
 	ctx   context.Context
 	cmd   ExecCmd
+	// the output format of proto message: table, json or yaml
+	Output string
+	// the results are collected instead of being printed when broadcasting command
+	collect bool
+	results []Result
+	// the progress of transfers is reported to it instead of progressbar when running in background
+	progress stream.ProgressCallback
 	Resolver
 	AuxResolver
 }
@@ -61,8 +77,31 @@ func NewResolverContext(ctx context.Context, resolver Resolver, auxResolver AuxR
 
 func (ctx *ResolverContext) DoProcess(param filter.Param) {
 	ctx.Param = param
+	ctx.Error = nil
 	if !ctx.Resolver.Run(param) {
 		// throw error
 		panic(status.ErrorIllegalOperation)
 	}
 }
+
+// DoCollect process the command and return the results instead of printing them
+func (ctx *ResolverContext) DoCollect(param filter.Param) []Result {
+	ctx.collect = true
+	ctx.results = nil
+	defer func() { ctx.collect = false }()
+	ctx.DoProcess(param)
+	return ctx.results
+}
+
+// SetProgress report the progress of transfers to fn instead of printing the progressbar
+func (ctx *ResolverContext) SetProgress(fn stream.ProgressCallback) {
+	ctx.progress = fn
+}
+
+// Progress the callback to report the progress of transfer, which updates the progressbar by default
+func (ctx *ResolverContext) Progress(bar *progressbar.ProgressBar) stream.ProgressCallback {
+	if ctx.progress != nil {
+		return ctx.progress
+	}
+	return bar.Update
+}
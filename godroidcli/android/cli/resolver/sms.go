@@ -75,7 +75,7 @@ func (s *Sms) dumpAllBasicSmsInfo() {
 			util.Green(str),
 		})
 	}
-	table.Filter(s.Param.Node).Print()
+	s.PrintResult(list, table)
 }
 
 func (s *Sms) dumpSmsInfoList(number string) {
@@ -117,7 +117,7 @@ func (s *Sms) dumpSmsInfoList(number string) {
 			util.TimeOf(info.ReceivedDate),
 		})
 	}
-	table.Filter(s.Param.Node).Print()
+	s.PrintResult(list, table)
 }
 
 func (s *Sms) dumpSendSms(dest, message string) {
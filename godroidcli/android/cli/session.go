@@ -17,6 +17,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/josexy/godroidcli/android/cli/resolver"
@@ -29,6 +30,7 @@ import (
 
 type Session struct {
 	sn        string // the serial number of device
+	opts      SessionOptions
 	address   string // rpc server ip address
 	port      int    // rpc server port
 	status    int    // connection status
@@ -39,13 +41,28 @@ type Session struct {
 	cancel    context.CancelFunc
 	proxy     *internal.SessionProxy
 	resolvers map[string]*resolver.ResolverContext
+	forwards  []Forward // port forwarding rules created for session
+	mu        sync.RWMutex
+	// whether the session is reconnecting, accessed atomically
+	reconnecting int32
+	// called when the supervisor gives up reconnecting
+	onGiveUp func()
+}
+
+// SessionOptions the connection options of session
+type SessionOptions struct {
+	TLS   bool   // connect to rpc server over TLS
+	Token string // pre-shared token sent with every rpc call
+	// send the token without TLS to a remote address
+	InsecureToken bool
 }
 
 const OpenSessionTimeout = time.Second * 4
 
-func NewSession(ctx context.Context, sn, address string, port int, adb *AdbCmd) (*Session, error) {
+func NewSession(ctx context.Context, sn, address string, port int, adb *AdbCmd, opts SessionOptions) (*Session, error) {
 	s := &Session{
 		sn:      sn,
+		opts:    opts,
 		address: address,
 		port:    port,
 		adb:     adb,
@@ -62,9 +79,17 @@ func NewSession(ctx context.Context, sn, address string, port int, adb *AdbCmd)
 	// set context value
 	s.adb.serialNumber = sn
 
-	// supported resolvers
+	s.initResolvers()
+	return s, nil
+}
+
+// initResolvers create all supported resolvers with current connection
+func (s *Session) initResolvers() {
+	s.resolvers = nil
+	fs := resolver.NewFileSystem(s.conn)
+	fs.SetSafeMode(util.GetConfig().SafeMode, util.GetConfig().TrashDir)
 	s.addResolver(s.ctx, internal.Pm, resolver.NewPackageManager(s.conn), s.adb)
-	s.addResolver(s.ctx, internal.Fs, resolver.NewFileSystem(s.conn), s.adb)
+	s.addResolver(s.ctx, internal.Fs, fs, s.adb)
 	s.addResolver(s.ctx, internal.Di, resolver.NewDevice(s.conn), s.adb)
 	s.addResolver(s.ctx, internal.Net, resolver.NewNetwork(s.conn), s.adb)
 	s.addResolver(s.ctx, internal.Ctrl, resolver.NewController(s.conn), s.adb)
@@ -73,7 +98,6 @@ func NewSession(ctx context.Context, sn, address string, port int, adb *AdbCmd)
 	s.addResolver(s.ctx, internal.Contact, resolver.NewContact(s.conn), s.adb)
 	s.addResolver(s.ctx, internal.CallLog, resolver.NewCallLog(s.conn), s.adb)
 	s.addResolver(s.ctx, internal.Phone, resolver.NewPhone(s.conn), s.adb)
-	return s, nil
 }
 
 func (s *Session) String() string {
@@ -86,20 +110,25 @@ func (s *Session) String() string {
 func (s *Session) CreateSessionProxy() *internal.SessionProxy {
 	if s.proxy == nil {
 		s.proxy = &internal.SessionProxy{}
-		s.proxy.IPackageManager = s.GetResolver(internal.Pm).(*resolver.PackageManager)
-		s.proxy.INetwork = s.GetResolver(internal.Net).(*resolver.Network)
-		s.proxy.IFileSystem = s.GetResolver(internal.Fs).(*resolver.FileSystem)
-		s.proxy.IDevice = s.GetResolver(internal.Di).(*resolver.Device)
-		s.proxy.IController = s.GetResolver(internal.Ctrl).(*resolver.Controller)
-		s.proxy.IMediaStore = s.GetResolver(internal.Ms).(*resolver.MediaStore)
-		s.proxy.ISms = s.GetResolver(internal.Sms).(*resolver.Sms)
-		s.proxy.IContact = s.GetResolver(internal.Contact).(*resolver.Contact)
-		s.proxy.ICallLog = s.GetResolver(internal.CallLog).(*resolver.CallLog)
-		s.proxy.IPhone = s.GetResolver(internal.Phone).(*resolver.Phone)
+		s.bindProxy()
 	}
 	return s.proxy
 }
 
+// bindProxy associate the current resolvers with session proxy
+func (s *Session) bindProxy() {
+	s.proxy.IPackageManager = s.GetResolver(internal.Pm).(*resolver.PackageManager)
+	s.proxy.INetwork = s.GetResolver(internal.Net).(*resolver.Network)
+	s.proxy.IFileSystem = s.GetResolver(internal.Fs).(*resolver.FileSystem)
+	s.proxy.IDevice = s.GetResolver(internal.Di).(*resolver.Device)
+	s.proxy.IController = s.GetResolver(internal.Ctrl).(*resolver.Controller)
+	s.proxy.IMediaStore = s.GetResolver(internal.Ms).(*resolver.MediaStore)
+	s.proxy.ISms = s.GetResolver(internal.Sms).(*resolver.Sms)
+	s.proxy.IContact = s.GetResolver(internal.Contact).(*resolver.Contact)
+	s.proxy.ICallLog = s.GetResolver(internal.CallLog).(*resolver.CallLog)
+	s.proxy.IPhone = s.GetResolver(internal.Phone).(*resolver.Phone)
+}
+
 // addResolver register resolver
 func (s *Session) addResolver(ctx context.Context, name string, r resolver.Resolver, cmd resolver.ExecCmd) {
 	if s.resolvers == nil {
@@ -117,12 +146,33 @@ func (s *Session) addResolver(ctx context.Context, name string, r resolver.Resol
 }
 
 func (s *Session) GetResolver(name string) resolver.Resolver {
-	if ctx, ok := s.resolvers[name]; ok {
+	if ctx, ok := s.getResolverContext(name); ok {
 		return ctx.Resolver
 	}
 	return nil
 }
 
+func (s *Session) getResolverContext(name string) (*resolver.ResolverContext, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ctx, ok := s.resolvers[name]
+	return ctx, ok
+}
+
+// setStatus change the connection status, which is read by the other goroutines
+func (s *Session) setStatus(status int) {
+	s.mu.Lock()
+	s.status = status
+	s.mu.Unlock()
+}
+
+// available check whether the connection is alive
+func (s *Session) available() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.status == alive
+}
+
 func (s *Session) pong() {
 	go func() {
 		for {
@@ -131,7 +181,12 @@ func (s *Session) pong() {
 				// cancel ping goroutine
 				s.cancel()
 				return
-			case s.status = <-s.pc.statusChan:
+			case status := <-s.pc.statusChan:
+				s.setStatus(status)
+				if status == unavailable {
+					// block until reconnected or given up
+					s.reconnect()
+				}
 			}
 		}
 	}()
@@ -139,25 +194,55 @@ func (s *Session) pong() {
 
 // openSession connect to rpc server within timeout
 func (s *Session) openSession(timeout time.Duration) (err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	s.conn, err = s.dial(timeout)
+	if err != nil {
+		return err
+	}
+	s.pc = NewPingContext(s.conn, s.ctx)
+	s.pc.ping()
+	s.pong()
+	return
+}
+
+// dial try to connect to rpc server within timeout
+func (s *Session) dial(timeout time.Duration) (*grpc.ClientConn, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, timeout)
 	defer cancel()
 
 	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
 		grpc.WithNoProxy(),
 		grpc.WithBlock(),
 	}
-	// try to connect to rpc server
-	s.conn, err = grpc.DialContext(ctx, fmt.Sprintf("%s:%d", s.address, s.port), opts...)
-	// connect timeout
+	if s.opts.TLS {
+		creds, err := newTransportCredentials(s.hostKey())
+		if err != nil {
+			return nil, err
+		}
+		// report the handshake error instead of waiting until timeout
+		opts = append(opts, grpc.WithTransportCredentials(creds),
+			grpc.FailOnNonTempDialError(true), grpc.WithReturnConnectionError())
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	if s.opts.Token != "" {
+		if err := s.checkTokenTransport(); err != nil {
+			return nil, err
+		}
+		opts = append(opts, tokenInterceptors(s.opts.Token)...)
+	}
+	conn, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", s.address, s.port), opts...)
 	if err != nil {
-		err = status.ErrConnTimeout
-		return err
+		if s.opts.TLS && ctx.Err() == nil {
+			return nil, err
+		}
+		// connect timeout
+		return nil, status.ErrConnTimeout
 	}
-	s.pc = NewPingContext(s.conn, s.ctx)
-	s.pc.ping()
-	s.pong()
-	return
+	if err = s.verifyAuth(conn); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return conn, nil
 }
 
 func (s *Session) CloseSession() (err error) {
@@ -165,21 +250,36 @@ func (s *Session) CloseSession() (err error) {
 		err = status.ErrConnNotExist
 		return
 	}
-	err = s.conn.Close()
+	// stop pinging and reconnecting before closing connection
 	if s.cancel != nil {
 		s.cancel()
 	}
+	err = s.conn.Close()
 	return
 }
 
-func (s *Session) executeCommand(param filter.Param) {
+func (s *Session) executeCommand(param filter.Param, output string) {
 	// recover
 	defer util.RecoverIllegalOption()
 
 	name := param.Args[1]
-	if m, ok := s.resolvers[name]; ok {
+	if m, ok := s.getResolverContext(name); ok {
+		m.Output = output
 		m.DoProcess(filter.Param{Node: param.Node, Args: param.Args[2:]})
 	} else {
 		util.Error("[%s] subcommand not supported", name)
 	}
 }
+
+// collectCommand execute the command and return the results instead of printing them
+func (s *Session) collectCommand(param filter.Param) (results []resolver.Result) {
+	defer util.RecoverIllegalOption()
+
+	name := param.Args[1]
+	if m, ok := s.getResolverContext(name); ok {
+		results = m.DoCollect(filter.Param{Node: param.Node, Args: param.Args[2:]})
+	} else {
+		util.Error("[%s] subcommand not supported", name)
+	}
+	return
+}
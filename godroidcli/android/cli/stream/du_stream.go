@@ -25,50 +25,47 @@ import (
 // HandleDownloadStream redirect download bytes stream to io.Writer
 // you also can convert the download bytes stream to os.File or gin.ResponseWriter
 func HandleDownloadStream(cs grpc.ClientStream, err error, writer io.Writer, fn ProgressCallback) error {
+	return HandleDownloadStreamFrom(cs, err, 0, writer, fn)
+}
+
+// HandleDownloadStreamFrom redirect download bytes stream which starts from the offset of file to io.Writer,
+// for example appending to a partial file, the progress includes the bytes before the offset
+func HandleDownloadStreamFrom(cs grpc.ClientStream, err error, offset int64, writer io.Writer, fn ProgressCallback) error {
+	return HandleDownloadStreamWith(cs, err, offset, writer, fn, DefaultOptions)
+}
+
+// HandleDownloadStreamWith is the same as HandleDownloadStreamFrom, but receives ahead of the writer by the options
+func HandleDownloadStreamWith(cs grpc.ClientStream, err error, offset int64, writer io.Writer, fn ProgressCallback, opts Options) error {
 	if err != nil {
 		return err
 	}
 	handler := NewStreamHandler(cs)
-	var present int64
-	err = handler.HandleGet(func(b []byte) error {
+	present := offset
+	err = handler.HandleGetWith(func(b []byte) error {
 		if fn != nil && handler.preSendData != -1 {
 			present += int64(len(b))
-			fn(present, handler.preSendData)
+			fn(present, offset+handler.preSendData)
 		}
 		_, err := writer.Write(b)
 		return err
-	})
+	}, opts.ReadAhead)
 	return err
 }
 
 // HandleUploadStream redirect upload bytes stream to io.Reader
 // you also can convert the os.File or gin.ResponseWriter to upload bytes stream
 func HandleUploadStream(cs grpc.ClientStream, err error, param []byte, reader io.Reader) error {
+	return HandleUploadStreamWith(cs, err, param, reader, DefaultOptions)
+}
+
+// HandleUploadStreamWith is the same as HandleUploadStream, but splits the reader into chunks by the options
+func HandleUploadStreamWith(cs grpc.ClientStream, err error, param []byte, reader io.Reader, opts Options) error {
 	if err != nil {
 		return err
 	}
-	buf := make([]byte, 4096)
-	first := true
 	// param: indicates whether to save the upload bytes stream to a local file
-	if param == nil {
-		first = false
-	}
 	handler := NewStreamHandler(cs)
-	err = handler.HandlePut(func() ([]byte, []byte, error) {
-		// if the parameter is not equal to nil, it is sent first: {filepath, content}
-		// send normally: {content}
-		n, err := reader.Read(buf[:])
-		if n <= 0 {
-			return nil, nil, err
-		}
-		if first {
-			// first send the save file path
-			first = false
-			return param, buf[:n], err
-		}
-		return nil, buf[:n], err
-	})
-	if err != nil {
+	if err = handler.HandlePutReader(param, reader, opts); err != nil {
 		return err
 	}
 	// get the final result from the server
@@ -0,0 +1,94 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package stream
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+const (
+	MinChunkSize     = 4 * 1024
+	DefaultChunkSize = 64 * 1024
+	MaxChunkSize     = 1024 * 1024
+	DefaultReadAhead = 4
+	// TargetSendTime is the time a single chunk is expected to take when sending adaptively
+	TargetSendTime = 50 * time.Millisecond
+)
+
+// Options controls how the bytes stream is split into messages
+type Options struct {
+	// ChunkSize is the initial size of each uploaded chunk
+	ChunkSize int
+	// Adaptive grows or shrinks the chunk size according to the time spent by each SendMsg
+	Adaptive bool
+	// ReadAhead is the number of chunks read ahead of the network, zero disables it
+	ReadAhead int
+}
+
+// DefaultOptions is used by HandleUploadStream and HandleDownloadStream
+var DefaultOptions = Options{
+	ChunkSize: DefaultChunkSize,
+	Adaptive:  true,
+	ReadAhead: DefaultReadAhead,
+}
+
+func (o Options) chunkSize() int {
+	switch {
+	case o.ChunkSize <= 0:
+		return DefaultChunkSize
+	case o.ChunkSize < MinChunkSize:
+		return MinChunkSize
+	case o.ChunkSize > MaxChunkSize:
+		return MaxChunkSize
+	}
+	return o.ChunkSize
+}
+
+// chunkSizer adjusts the chunk size, it is shared by the reader and the sender
+type chunkSizer struct {
+	size     int64
+	adaptive bool
+}
+
+func newChunkSizer(opts Options) *chunkSizer {
+	return &chunkSizer{size: int64(opts.chunkSize()), adaptive: opts.Adaptive}
+}
+
+func (s *chunkSizer) Size() int {
+	return int(atomic.LoadInt64(&s.size))
+}
+
+// Observe records that a chunk of n bytes read with the given size took elapsed to be sent,
+// the size doubles when a full chunk is sent quickly and halves when the network is slow
+func (s *chunkSizer) Observe(n, size int, elapsed time.Duration) {
+	if !s.adaptive {
+		return
+	}
+	next := size
+	switch {
+	case elapsed < TargetSendTime/2 && n == size:
+		if next *= 2; next > MaxChunkSize {
+			next = MaxChunkSize
+		}
+	case elapsed > TargetSendTime*2:
+		if next /= 2; next < MinChunkSize {
+			next = MinChunkSize
+		}
+	}
+	if next != size {
+		atomic.CompareAndSwapInt64(&s.size, int64(size), int64(next))
+	}
+}
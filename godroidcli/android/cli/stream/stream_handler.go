@@ -15,12 +15,16 @@
 package stream
 
 import (
+	"errors"
 	"io"
+	"time"
 
 	pb "github.com/josexy/godroidcli/protobuf"
 	"google.golang.org/grpc"
 )
 
+var errStopped = errors.New("stream stopped")
+
 type (
 	ProgressCallback func(present, total int64)
 	WriteCallback    func(bytes []byte) error
@@ -58,13 +62,48 @@ func convertBytes2Long(bs []byte) (value int64) {
 
 // HandleGet receive the bytes stream from server (download)
 func (h *Handler) HandleGet(callback WriteCallback) error {
-	m := new(pb.Bytes)
+	return h.HandleGetWith(callback, 0)
+}
+
+// HandleGetWith receive the bytes stream from server (download),
+// up to readAhead messages are received while the callback is still writing
+func (h *Handler) HandleGetWith(callback WriteCallback, readAhead int) error {
+	if readAhead <= 0 {
+		return h.receive(callback)
+	}
+	values := make(chan []byte, readAhead)
+	done := make(chan struct{})
+	defer close(done)
+
+	var err error
+	go func() {
+		defer close(values)
+		err = h.receive(func(b []byte) error {
+			select {
+			case values <- b:
+				return nil
+			case <-done:
+				return errStopped
+			}
+		})
+	}()
+	for b := range values {
+		if werr := callback.Write(b); werr != nil {
+			return werr
+		}
+	}
+	return err
+}
+
+func (h *Handler) receive(callback WriteCallback) error {
 	var err error
 	state := 0
 	// format: | mask | [extra parameter] | bytes stream |
 	// the extra parameter indicates the total size of the received byte stream
 completed:
 	for {
+		// a new message every time, so that the value can be handed over to another goroutine
+		m := new(pb.Bytes)
 		err = h.cs.RecvMsg(m)
 		if err != nil {
 			if err == io.EOF {
@@ -129,6 +168,122 @@ func (h *Handler) HandlePut(callback ReadCallback) error {
 	return err
 }
 
+// chunk is a piece of the bytes stream waiting to be sent
+type chunk struct {
+	param []byte
+	buf   []byte
+	n     int
+	size  int
+	err   error
+}
+
+// chunkReader splits the reader into chunks, the param is attached to the first non-empty chunk
+type chunkReader struct {
+	reader io.Reader
+	param  []byte
+	sizer  *chunkSizer
+}
+
+func (r *chunkReader) next(buf []byte) *chunk {
+	size := r.sizer.Size()
+	if cap(buf) < size {
+		buf = make([]byte, size)
+	}
+	c := &chunk{buf: buf, size: size}
+	c.n, c.err = io.ReadFull(r.reader, buf[:size])
+	if c.err == io.ErrUnexpectedEOF {
+		c.err = io.EOF
+	}
+	if c.n > 0 && r.param != nil {
+		c.param, r.param = r.param, nil
+	}
+	return c
+}
+
+// HandlePutReader send the bytes read from reader to server (upload),
+// if the param is not nil, it is sent along with the first chunk: {filepath, content}.
+// The chunks are read ahead of SendMsg by a separate goroutine and their buffers are reused
+func (h *Handler) HandlePutReader(param []byte, reader io.Reader, opts Options) error {
+	sizer := newChunkSizer(opts)
+	r := &chunkReader{reader: reader, param: param, sizer: sizer}
+	chunks := make(chan *chunk, opts.ReadAhead)
+	free := make(chan []byte, opts.ReadAhead+2)
+	done := make(chan struct{})
+	defer close(done)
+
+	if opts.ReadAhead > 0 {
+		go func() {
+			defer close(chunks)
+			for {
+				var buf []byte
+				select {
+				case buf = <-free:
+				default:
+				}
+				c := r.next(buf)
+				select {
+				case chunks <- c:
+				case <-done:
+					return
+				}
+				if c.err != nil {
+					return
+				}
+			}
+		}()
+	}
+	nextChunk := func(buf []byte) *chunk {
+		if opts.ReadAhead <= 0 {
+			return r.next(buf)
+		}
+		if buf != nil {
+			free <- buf
+		}
+		c, ok := <-chunks
+		if !ok {
+			return &chunk{err: io.EOF}
+		}
+		return c
+	}
+
+	var err error
+	var buf []byte
+	m := new(pb.ParamBytes)
+	m.Value = new(pb.Bytes)
+	for {
+		c := nextChunk(buf)
+		buf = c.buf
+		if c.n > 0 {
+			m.Param = nil
+			if c.param != nil {
+				m.Param = &pb.String{Value: string(c.param)}
+			}
+			m.Value.Value = c.buf[:c.n]
+			start := time.Now()
+			if err = h.cs.SendMsg(m); err != nil {
+				// io.EOF means the server has terminated the stream,
+				// the status is returned by the final result
+				if err == io.EOF {
+					err = nil
+				}
+				break
+			}
+			sizer.Observe(c.n, c.size, time.Since(start))
+		}
+		if c.err != nil {
+			if c.err != io.EOF {
+				err = c.err
+			}
+			break
+		}
+	}
+	// client can not send message but it can receive the final result
+	if cerr := h.cs.CloseSend(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
 // GetFinalResult the final result is stored in pb.Status
 func (h *Handler) GetFinalResult() (*pb.Status, error) {
 	m := new(pb.Status)
@@ -0,0 +1,185 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/josexy/godroidcli/android/cli/stream/streamtest"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"google.golang.org/grpc"
+)
+
+func newTestClient(tb testing.TB) (pb.FsResolverClient, *streamtest.FsServer) {
+	fs := &streamtest.FsServer{ChunkSize: 256 * 1024}
+	return pb.NewFsResolverClient(streamtest.Dial(tb, fs)), fs
+}
+
+func randomBytes(n int) []byte {
+	data := make([]byte, n)
+	rand.New(rand.NewSource(int64(n))).Read(data)
+	return data
+}
+
+func TestUploadDownloadStream(t *testing.T) {
+	client, fs := newTestClient(t)
+	options := map[string]Options{
+		"fixed":      {ChunkSize: MinChunkSize},
+		"read-ahead": {ChunkSize: MinChunkSize, ReadAhead: 1},
+		"default":    DefaultOptions,
+	}
+	for name, opts := range options {
+		for _, size := range []int{1, MinChunkSize, 3*DefaultChunkSize + 7, 5 * MaxChunkSize} {
+			data := randomBytes(size)
+			s, err := client.UploadGeneralFile(context.Background())
+			if err = HandleUploadStreamWith(s, err, []byte("/sdcard/test"), bytes.NewReader(data), opts); err != nil {
+				t.Fatalf("%s %d: %v", name, size, err)
+			}
+			if fs.Path != "/sdcard/test" || !bytes.Equal(fs.Data, data) {
+				t.Fatalf("%s %d: upload mismatch, path %q, size %d", name, size, fs.Path, len(fs.Data))
+			}
+			if !opts.Adaptive && fs.Msgs != (size+MinChunkSize-1)/MinChunkSize {
+				t.Fatalf("%s %d: got %d messages", name, size, fs.Msgs)
+			}
+
+			var buf bytes.Buffer
+			d, err := client.DownloadGeneralFile(context.Background(), &pb.FileRange{})
+			if err = HandleDownloadStreamWith(d, err, 0, &buf, nil, opts); err != nil {
+				t.Fatalf("%s %d: %v", name, size, err)
+			}
+			if !bytes.Equal(buf.Bytes(), data) {
+				t.Fatalf("%s %d: download mismatch, size %d", name, size, buf.Len())
+			}
+		}
+	}
+}
+
+// legacyUpload send the bytes stream in 4KB chunks by the callback of HandlePut, as it was before the options
+func legacyUpload(cs grpc.ClientStream, param []byte, reader io.Reader) error {
+	buf := make([]byte, 4096)
+	handler := NewStreamHandler(cs)
+	err := handler.HandlePut(func() ([]byte, []byte, error) {
+		n, err := reader.Read(buf)
+		if n <= 0 {
+			return nil, nil, err
+		}
+		p := param
+		param = nil
+		return p, buf[:n], err
+	})
+	if err != nil {
+		return err
+	}
+	_, err = handler.GetFinalResult()
+	return err
+}
+
+// legacyDownload receive the bytes stream into a single reused message without reading ahead,
+// as it was before the options
+func legacyDownload(cs grpc.ClientStream, writer io.Writer) error {
+	m := new(pb.Bytes)
+	for state := 0; ; {
+		if err := cs.RecvMsg(m); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		switch state {
+		case 0:
+			state = 1
+			if m.Value[0] != 0xAA {
+				state = 2
+			}
+		case 1:
+			state = 2
+		case 2:
+			if _, err := writer.Write(m.Value); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func BenchmarkUploadStream(b *testing.B) {
+	client, _ := newTestClient(b)
+	data := randomBytes(16 * 1024 * 1024)
+	options := []struct {
+		name   string
+		upload func(grpc.ClientStream) error
+	}{
+		{"legacy-4KB", func(cs grpc.ClientStream) error {
+			return legacyUpload(cs, []byte("/sdcard/bench"), bytes.NewReader(data))
+		}},
+		{"fixed-4KB", func(cs grpc.ClientStream) error {
+			return HandleUploadStreamWith(cs, nil, []byte("/sdcard/bench"), bytes.NewReader(data), Options{ChunkSize: MinChunkSize})
+		}},
+		{"default", func(cs grpc.ClientStream) error {
+			return HandleUploadStreamWith(cs, nil, []byte("/sdcard/bench"), bytes.NewReader(data), DefaultOptions)
+		}},
+	}
+	for _, o := range options {
+		b.Run(o.name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				s, err := client.UploadGeneralFile(context.Background())
+				if err != nil {
+					b.Fatal(err)
+				}
+				if err = o.upload(s); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDownloadStream(b *testing.B) {
+	client, fs := newTestClient(b)
+	fs.Data = randomBytes(16 * 1024 * 1024)
+	// the server used to send 4KB chunks, and now the chunks grow up to 256KB
+	options := []struct {
+		name      string
+		chunkSize int
+		legacy    bool
+	}{
+		{"legacy-4KB", 4096, true},
+		{"read-ahead-4KB", 4096, false},
+		{"default", 256 * 1024, false},
+	}
+	for _, o := range options {
+		b.Run(o.name, func(b *testing.B) {
+			fs.ChunkSize = o.chunkSize
+			b.SetBytes(int64(len(fs.Data)))
+			for i := 0; i < b.N; i++ {
+				d, err := client.DownloadGeneralFile(context.Background(), &pb.FileRange{})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if o.legacy {
+					err = legacyDownload(d, io.Discard)
+				} else {
+					err = HandleDownloadStreamWith(d, nil, 0, io.Discard, nil, DefaultOptions)
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package streamtest provides an in-memory file system server for testing the transfers of bytes stream
+package streamtest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/josexy/godroidcli/protobuf"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// DefaultChunkSize the size of chunks downloaded by default, it is small enough to abort the stream at any offset
+const DefaultChunkSize = 16
+
+// FsServer keep a single file in memory, which is replaced by the uploaded bytes stream and
+// served back in chunks. The file operations are recorded instead of being applied
+type FsServer struct {
+	pb.UnimplementedFsResolverServer
+	mu sync.Mutex
+	// the contents of file
+	Data []byte
+	// the size of chunks downloaded, DefaultChunkSize if zero
+	ChunkSize int
+	// abort the first download after AbortAfter bytes
+	AbortAfter int
+	// the offsets of downloads requested
+	Offsets []int64
+	// the entries of remote directories, the directory not in it can not be listed
+	Dirs map[string][]*pb.FileInfo
+	// the number of directories listed
+	Lists int
+	// the path, the number of messages of last upload and the number of uploads
+	Path    string
+	Msgs    int
+	Uploads int
+	// the file operations received, such as "move a b"
+	Ops []string
+	// the number of digests calculated
+	Hashes int
+}
+
+func (s *FsServer) UploadGeneralFile(stream pb.FsResolver_UploadGeneralFileServer) error {
+	var buf bytes.Buffer
+	path, msgs := "", 0
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		if m.Param != nil {
+			if msgs > 0 {
+				return errors.New("param sent after the first chunk")
+			}
+			path = m.Param.Value
+		}
+		msgs++
+		buf.Write(m.Value.Value)
+	}
+	s.mu.Lock()
+	s.Data, s.Path, s.Msgs = buf.Bytes(), path, msgs
+	s.Uploads++
+	s.mu.Unlock()
+	return stream.SendAndClose(&pb.Status{Status: pb.Status_SUCCEED})
+}
+
+func (s *FsServer) DownloadGeneralFile(req *pb.FileRange, stream pb.FsResolver_DownloadGeneralFileServer) error {
+	s.mu.Lock()
+	s.Offsets = append(s.Offsets, req.Offset)
+	abort := len(s.Offsets) == 1 && s.AbortAfter > 0
+	data := s.Data[req.Offset:]
+	s.mu.Unlock()
+
+	if req.Length > 0 && req.Length < int64(len(data)) {
+		data = data[:req.Length]
+	}
+	chunkSize := s.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	size := make([]byte, 8)
+	for i, n := 7, int64(len(data)); i >= 0; i, n = i-1, n>>8 {
+		size[i] = byte(n)
+	}
+	// format: | mask | extra parameter | bytes stream |
+	if err := stream.Send(&pb.Bytes{Value: []byte{0xAA}}); err != nil {
+		return err
+	}
+	if err := stream.Send(&pb.Bytes{Value: size}); err != nil {
+		return err
+	}
+	for sent := 0; sent < len(data); sent += chunkSize {
+		if abort && int(req.Offset)+sent >= s.AbortAfter {
+			return status.Error(codes.Unavailable, "connection lost")
+		}
+		end := sent + chunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := stream.Send(&pb.Bytes{Value: data[sent:end]}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FsServer) record(op string, args ...string) (*pb.Status, error) {
+	s.mu.Lock()
+	s.Ops = append(s.Ops, strings.Join(append([]string{op}, args...), " "))
+	s.mu.Unlock()
+	return &pb.Status{Status: pb.Status_SUCCEED}, nil
+}
+
+func (s *FsServer) MkDir(_ context.Context, req *pb.String) (*pb.Status, error) {
+	return s.record("mkdir", req.Value)
+}
+
+func (s *FsServer) RmDir(_ context.Context, req *pb.String) (*pb.Status, error) {
+	return s.record("rmdir", req.Value)
+}
+
+func (s *FsServer) Move(_ context.Context, req *pb.StringPair) (*pb.Status, error) {
+	return s.record("move", req.First, req.Second)
+}
+
+func (s *FsServer) Rename(_ context.Context, req *pb.StringPair) (*pb.Status, error) {
+	return s.record("rename", req.First, req.Second)
+}
+
+func (s *FsServer) ListDir(_ context.Context, req *pb.StringPair) (*pb.FileInfoList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Lists++
+	list, ok := s.Dirs[req.First]
+	if !ok {
+		return nil, status.Error(codes.Unknown, "can not list directory")
+	}
+	return &pb.FileInfoList{Values: list}, nil
+}
+
+func (s *FsServer) GetFileHash(_ context.Context, _ *pb.StringPair) (*pb.String, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Hashes++
+	digest := sha256.Sum256(s.Data)
+	return &pb.String{Value: hex.EncodeToString(digest[:])}, nil
+}
+
+// Dial serve the server in memory and return the connection to it, both are closed when the test finishes
+func Dial(tb testing.TB, server *FsServer) *grpc.ClientConn {
+	lis := bufconn.Listen(4 * 1024 * 1024)
+	srv := grpc.NewServer()
+	pb.RegisterFsResolverServer(srv, server)
+	go func() { _ = srv.Serve(lis) }()
+	conn, err := grpc.Dial("bufconn", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	tb.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+	return conn
+}
@@ -0,0 +1,183 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package cli
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/josexy/godroidcli/status"
+	"github.com/josexy/godroidcli/util"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	OptionTLS   = "--tls"
+	OptionToken = "--token"
+	// OptionInsecureToken allow sending the token without TLS over network
+	OptionInsecureToken = "--insecure-token"
+	fingerprintPrefix   = "sha256:"
+)
+
+// knownHosts the fingerprint store of server certificates, each line of file is:
+// NAME sha256:HEX
+type knownHosts struct {
+	file string
+	mu   sync.Mutex
+}
+
+var (
+	hostStore = &knownHosts{}
+	// the file of store is set on the first dial, since the verifications may be running concurrently
+	hostStoreOnce sync.Once
+)
+
+// parseSessionOptions remove the options from arguments
+func parseSessionOptions(args []string) (rest []string, opts SessionOptions) {
+	for i := 0; i < len(args); i++ {
+		switch {
+		case args[i] == OptionTLS:
+			opts.TLS = true
+		case args[i] == OptionInsecureToken:
+			opts.InsecureToken = true
+		case args[i] == OptionToken && i+1 < len(args):
+			i++
+			opts.Token = args[i]
+		default:
+			rest = append(rest, args[i])
+		}
+	}
+	return
+}
+
+// hostKey the name of server in fingerprint store
+func (s *Session) hostKey() string {
+	if s.sn != "" && s.sn != s.address {
+		return s.sn
+	}
+	return fmt.Sprintf("%s:%d", s.address, s.port)
+}
+
+// newTransportCredentials create the TLS credentials for the server.
+// if the CA certificate is configured, the server certificate must be signed by the pinned CA,
+// otherwise the server certificate is trusted on first use and verified by its fingerprint later
+func newTransportCredentials(name string) (credentials.TransportCredentials, error) {
+	conf := util.GetConfig().TLS
+	if conf == nil {
+		conf = &util.TLSConfig{}
+	}
+	tlsConf := &tls.Config{
+		ServerName: conf.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+	// mutual TLS
+	if conf.CertFile != "" && conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConf.Certificates = []tls.Certificate{cert}
+	}
+
+	if conf.CAFile != "" {
+		data, err := os.ReadFile(conf.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate found in %s", conf.CAFile)
+		}
+		tlsConf.RootCAs = pool
+	} else {
+		hostStoreOnce.Do(func() {
+			hostStore.file = conf.KnownHostsFile
+			if hostStore.file == "" {
+				hostStore.file = util.KnownHostsFile
+			}
+		})
+		// the self-signed certificate is verified by fingerprint instead of CA
+		tlsConf.InsecureSkipVerify = true
+		tlsConf.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if len(rawCerts) == 0 {
+				return errors.New("server certificate not provided")
+			}
+			return hostStore.verify(name, fingerprint(rawCerts[0]))
+		}
+	}
+	return credentials.NewTLS(tlsConf), nil
+}
+
+func fingerprint(cert []byte) string {
+	sum := sha256.Sum256(cert)
+	return fingerprintPrefix + hex.EncodeToString(sum[:])
+}
+
+func (k *knownHosts) load() (map[string]string, error) {
+	hosts := make(map[string]string)
+	fp, err := os.Open(k.file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return hosts, nil
+		}
+		return nil, err
+	}
+	defer func() { _ = fp.Close() }()
+
+	scanner := bufio.NewScanner(fp)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			hosts[fields[0]] = fields[1]
+		}
+	}
+	return hosts, scanner.Err()
+}
+
+// verify compare the fingerprint with the saved one, the fingerprint of unknown server is saved
+func (k *knownHosts) verify(name, fingerprint string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	hosts, err := k.load()
+	if err != nil {
+		return err
+	}
+	if known, ok := hosts[name]; ok {
+		if known != fingerprint {
+			return fmt.Errorf("%w: [%s] %s, remove it from %s if the certificate was changed",
+				status.ErrFingerprintMismatch, name, fingerprint, k.file)
+		}
+		return nil
+	}
+	fp, err := os.OpenFile(k.file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = fp.Close() }()
+	if _, err = fmt.Fprintf(fp, "%s %s\n", name, fingerprint); err != nil {
+		return err
+	}
+	util.Warn("trust the certificate of [%s] on first use: %s", name, fingerprint)
+	return nil
+}
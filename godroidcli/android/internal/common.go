@@ -68,6 +68,23 @@ const (
 	AppendText    = "append"
 	WriteText     = "write"
 	ReadText      = "read"
+	Hash          = "hash"
+	Sync          = "sync"
+	Find          = "find"
+	Du            = "du"
+	Top           = "top"
+	Cat           = "cat"
+	Head          = "head"
+	Tail          = "tail"
+	Edit          = "edit"
+	Trash         = "trash"
+	Undo          = "undo"
+	Archive       = "archive"
+)
+
+const (
+	TarGz = "tar.gz"
+	Zip   = "zip"
 )
 
 const (
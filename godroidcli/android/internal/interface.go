@@ -64,6 +64,10 @@ type IFileSystem interface {
 	ReadText(string) (*pb.Status, error)
 	UploadFile(io.Reader, string) error
 	DownloadFile(string, io.Writer, stream.ProgressCallback) error
+	DownloadFileRange(string, int64, int64, io.Writer, stream.ProgressCallback) error
+	SetLastModified(string, int64) error
+	GetFileHash(string, string) (*pb.String, error)
+	Archive(string, string, io.Writer, stream.ProgressCallback) error
 }
 
 type IDevice interface {
@@ -0,0 +1,292 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package vfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"syscall"
+	"time"
+
+	"github.com/hanwen/go-fuse/v2/fs"
+	"github.com/hanwen/go-fuse/v2/fuse"
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"github.com/josexy/godroidcli/util"
+)
+
+// node a file or directory of the mounted file system
+type node struct {
+	fs.Inode
+	remote *RemoteFS
+}
+
+var (
+	_ fs.NodeLookuper  = (*node)(nil)
+	_ fs.NodeGetattrer = (*node)(nil)
+	_ fs.NodeSetattrer = (*node)(nil)
+	_ fs.NodeReaddirer = (*node)(nil)
+	_ fs.NodeOpener    = (*node)(nil)
+	_ fs.NodeCreater   = (*node)(nil)
+	_ fs.NodeMkdirer   = (*node)(nil)
+	_ fs.NodeUnlinker  = (*node)(nil)
+	_ fs.NodeRmdirer   = (*node)(nil)
+	_ fs.NodeRenamer   = (*node)(nil)
+)
+
+// handle the opened file, which reads from the remote file or writes to the temporary file
+type handle struct {
+	reader *FileReader
+	writer *FileWriter
+}
+
+var (
+	_ fs.FileReader   = (*handle)(nil)
+	_ fs.FileWriter   = (*handle)(nil)
+	_ fs.FileFlusher  = (*handle)(nil)
+	_ fs.FileReleaser = (*handle)(nil)
+)
+
+func toErrno(err error) syscall.Errno {
+	switch {
+	case err == nil:
+		return fs.OK
+	case errors.Is(err, os.ErrNotExist):
+		return syscall.ENOENT
+	}
+	return syscall.EIO
+}
+
+func fileMode(fi *pb.FileInfo) uint32 {
+	if fi.Dir {
+		return fuse.S_IFDIR | 0755
+	}
+	return fuse.S_IFREG | 0644
+}
+
+func fillAttr(fi *pb.FileInfo, out *fuse.Attr) {
+	out.Mode = fileMode(fi)
+	out.Size = uint64(fi.Size)
+	out.Blocks = (out.Size + 511) / 512
+	out.Nlink = 1
+	out.Uid = uint32(os.Getuid())
+	out.Gid = uint32(os.Getgid())
+	mtime := time.UnixMilli(fi.LastModifiedTime)
+	out.SetTimes(&mtime, &mtime, &mtime)
+}
+
+// name the path relative to the root directory
+func (n *node) name() string {
+	return n.Path(n.Root())
+}
+
+func (n *node) child(name string) string {
+	return path.Join(n.name(), name)
+}
+
+func (n *node) newChild(ctx context.Context, fi *pb.FileInfo, out *fuse.EntryOut) *fs.Inode {
+	fillAttr(fi, &out.Attr)
+	return n.NewInode(ctx, &node{remote: n.remote}, fs.StableAttr{Mode: fileMode(fi) & syscall.S_IFMT})
+}
+
+func (n *node) Lookup(ctx context.Context, name string, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	fi, err := n.remote.Stat(n.child(name))
+	if err != nil {
+		return nil, toErrno(err)
+	}
+	return n.newChild(ctx, fi, out), fs.OK
+}
+
+func (n *node) Getattr(ctx context.Context, fh fs.FileHandle, out *fuse.AttrOut) syscall.Errno {
+	fi, err := n.remote.Stat(n.name())
+	if err != nil {
+		return toErrno(err)
+	}
+	fillAttr(fi, &out.Attr)
+	// the file is being written
+	if h, ok := fh.(*handle); ok && h.writer != nil {
+		size, err := h.writer.Size()
+		if err != nil {
+			return toErrno(err)
+		}
+		out.Size = uint64(size)
+	}
+	return fs.OK
+}
+
+func (n *node) Setattr(ctx context.Context, fh fs.FileHandle, in *fuse.SetAttrIn, out *fuse.AttrOut) syscall.Errno {
+	h, _ := fh.(*handle)
+	if size, ok := in.GetSize(); ok {
+		var err error
+		if h != nil && h.writer != nil {
+			err = h.writer.Truncate(int64(size))
+		} else {
+			var w *FileWriter
+			if w, err = n.remote.NewFileWriter(n.name(), size == 0); err == nil {
+				if err = w.Truncate(int64(size)); err == nil {
+					err = w.Close()
+				}
+			}
+		}
+		if err != nil {
+			return toErrno(err)
+		}
+	}
+	if mtime, ok := in.GetMTime(); ok {
+		// upload the written bytes first, otherwise the modification time will be overwritten
+		if h != nil && h.writer != nil {
+			if err := h.writer.Flush(); err != nil {
+				return toErrno(err)
+			}
+		}
+		if err := n.remote.SetModTime(n.name(), mtime); err != nil {
+			return toErrno(err)
+		}
+	}
+	return n.Getattr(ctx, fh, out)
+}
+
+func (n *node) Readdir(ctx context.Context) (fs.DirStream, syscall.Errno) {
+	list, err := n.remote.ListDir(n.name())
+	if err != nil {
+		return nil, toErrno(err)
+	}
+	entries := make([]fuse.DirEntry, 0, len(list))
+	for _, fi := range list {
+		entries = append(entries, fuse.DirEntry{Name: fi.Name, Mode: fileMode(fi)})
+	}
+	return fs.NewListDirStream(entries), fs.OK
+}
+
+func (n *node) Open(ctx context.Context, flags uint32) (fs.FileHandle, uint32, syscall.Errno) {
+	if flags&(syscall.O_WRONLY|syscall.O_RDWR) != 0 {
+		w, err := n.remote.NewFileWriter(n.name(), flags&syscall.O_TRUNC != 0)
+		if err != nil {
+			return nil, 0, toErrno(err)
+		}
+		return &handle{writer: w}, fuse.FOPEN_DIRECT_IO, fs.OK
+	}
+	fi, err := n.remote.Stat(n.name())
+	if err != nil {
+		return nil, 0, toErrno(err)
+	}
+	return &handle{reader: n.remote.NewFileReader(n.name(), fi)}, 0, fs.OK
+}
+
+func (n *node) Create(ctx context.Context, name string, flags uint32, mode uint32,
+	out *fuse.EntryOut) (*fs.Inode, fs.FileHandle, uint32, syscall.Errno) {
+	file := n.child(name)
+	if err := n.remote.Create(file); err != nil {
+		return nil, nil, 0, toErrno(err)
+	}
+	w, err := n.remote.NewFileWriter(file, true)
+	if err != nil {
+		return nil, nil, 0, toErrno(err)
+	}
+	fi := &pb.FileInfo{Name: name, LastModifiedTime: time.Now().UnixMilli()}
+	return n.newChild(ctx, fi, out), &handle{writer: w}, fuse.FOPEN_DIRECT_IO, fs.OK
+}
+
+func (n *node) Mkdir(ctx context.Context, name string, mode uint32, out *fuse.EntryOut) (*fs.Inode, syscall.Errno) {
+	dir := n.child(name)
+	if err := n.remote.MkDir(dir); err != nil {
+		return nil, toErrno(err)
+	}
+	fi, err := n.remote.Stat(dir)
+	if err != nil {
+		return nil, toErrno(err)
+	}
+	return n.newChild(ctx, fi, out), fs.OK
+}
+
+func (n *node) Unlink(ctx context.Context, name string) syscall.Errno {
+	return toErrno(n.remote.Remove(n.child(name), false))
+}
+
+func (n *node) Rmdir(ctx context.Context, name string) syscall.Errno {
+	return toErrno(n.remote.Remove(n.child(name), true))
+}
+
+func (n *node) Rename(ctx context.Context, name string, newParent fs.InodeEmbedder, newName string, flags uint32) syscall.Errno {
+	parent, ok := newParent.(*node)
+	if !ok {
+		return syscall.EXDEV
+	}
+	return toErrno(n.remote.Rename(n.child(name), parent.child(newName)))
+}
+
+func (h *handle) Read(ctx context.Context, dest []byte, off int64) (fuse.ReadResult, syscall.Errno) {
+	var n int
+	var err error
+	if h.writer != nil {
+		n, err = h.writer.ReadAt(dest, off)
+	} else {
+		n, err = h.reader.ReadAt(dest, off)
+	}
+	if err != nil && err != io.EOF {
+		return nil, toErrno(err)
+	}
+	return fuse.ReadResultData(dest[:n]), fs.OK
+}
+
+func (h *handle) Write(ctx context.Context, data []byte, off int64) (uint32, syscall.Errno) {
+	if h.writer == nil {
+		return 0, syscall.EBADF
+	}
+	n, err := h.writer.WriteAt(data, off)
+	return uint32(n), toErrno(err)
+}
+
+func (h *handle) Flush(ctx context.Context) syscall.Errno {
+	if h.writer == nil {
+		return fs.OK
+	}
+	return toErrno(h.writer.Flush())
+}
+
+func (h *handle) Release(ctx context.Context) syscall.Errno {
+	if h.writer == nil {
+		return fs.OK
+	}
+	return toErrno(h.writer.Close())
+}
+
+// Mount expose the remote directory at the mountpoint as a FUSE file system,
+// and block until it is unmounted or interrupted
+func Mount(proxy *internal.SessionProxy, mountpoint, root string) error {
+	remote := NewRemoteFS(proxy, root)
+	timeout := time.Second
+	server, err := fs.Mount(mountpoint, &node{remote: remote}, &fs.Options{
+		MountOptions: fuse.MountOptions{FsName: remote.root, Name: "godroidcli"},
+		EntryTimeout: &timeout,
+		AttrTimeout:  &timeout,
+	})
+	if err != nil {
+		return err
+	}
+	util.Info("mount %s on %s", util.Green(remote.root), util.Green(mountpoint))
+	util.Info("press %s or run %s to unmount", util.Green("Ctrl+C"), util.Green("fusermount -u "+mountpoint))
+	go func() {
+		<-util.MakeInterruptChan()
+		if err := server.Unmount(); err != nil {
+			util.ErrorBy(err)
+		}
+	}()
+	server.Wait()
+	return nil
+}
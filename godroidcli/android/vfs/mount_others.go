@@ -0,0 +1,27 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build !linux
+
+package vfs
+
+import (
+	"github.com/josexy/godroidcli/android/internal"
+	"github.com/josexy/godroidcli/status"
+)
+
+// Mount the FUSE file system is only supported on Linux
+func Mount(proxy *internal.SessionProxy, mountpoint, root string) error {
+	return status.ErrMountNotSupported
+}
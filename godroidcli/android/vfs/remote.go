@@ -0,0 +1,395 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package vfs
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+)
+
+const (
+	// DefaultRoot the remote directory to access by default
+	DefaultRoot = "/sdcard"
+	// MetadataCacheTTL the time to live of the cached directory entries
+	MetadataCacheTTL = 5 * time.Second
+	// SmallFileSize the file whose size is not greater than it is read entirely and cached
+	SmallFileSize = 1 << 20
+	// MaxContentCacheSize the max total size of the cached small files
+	MaxContentCacheSize = 64 << 20
+	// ReadAheadSize the size of block to read ahead for large file
+	ReadAheadSize = 1 << 20
+	// TempFileSuffix the suffix of temporary file which is uploaded and then renamed to the written file
+	TempFileSuffix = ".godroidcli-tmp"
+)
+
+type dirEntry struct {
+	list   []*pb.FileInfo
+	expire time.Time
+}
+
+type contentEntry struct {
+	data  []byte
+	mtime int64
+}
+
+// RemoteFS access the directory of Android device through the session proxy,
+// the entries of directories and the contents of small files are cached,
+// and they are invalidated when the directory or file is changed
+type RemoteFS struct {
+	proxy       *internal.SessionProxy
+	root        string
+	mu          sync.Mutex
+	dirs        map[string]dirEntry
+	contents    map[string]contentEntry
+	contentSize int64
+}
+
+func NewRemoteFS(proxy *internal.SessionProxy, root string) *RemoteFS {
+	return &RemoteFS{
+		proxy:    proxy,
+		root:     path.Clean("/" + root),
+		dirs:     make(map[string]dirEntry),
+		contents: make(map[string]contentEntry),
+	}
+}
+
+// Abs the remote path of name which is relative to the root directory
+func (r *RemoteFS) Abs(name string) string {
+	return path.Join(r.root, path.Clean("/"+name))
+}
+
+// ListDir list the entries of directory, the names of entries are base names
+func (r *RemoteFS) ListDir(name string) ([]*pb.FileInfo, error) {
+	dir := r.Abs(name)
+	r.mu.Lock()
+	entry, ok := r.dirs[dir]
+	r.mu.Unlock()
+	if ok && time.Now().Before(entry.expire) {
+		return entry.list, nil
+	}
+	list, err := r.proxy.ListDir(dir, "all")
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*pb.FileInfo, 0, len(list.Values))
+	for _, fi := range list.Values {
+		fi.Name = path.Base(fi.Name)
+		entries = append(entries, fi)
+	}
+	r.mu.Lock()
+	r.dirs[dir] = dirEntry{list: entries, expire: time.Now().Add(MetadataCacheTTL)}
+	r.mu.Unlock()
+	return entries, nil
+}
+
+// Stat get the information of file or directory from the entries of its parent directory
+func (r *RemoteFS) Stat(name string) (*pb.FileInfo, error) {
+	name = path.Clean("/" + name)
+	if name == "/" {
+		return &pb.FileInfo{Name: path.Base(r.root), Dir: true, Readable: true, Writable: true}, nil
+	}
+	list, err := r.ListDir(path.Dir(name))
+	if err != nil {
+		return nil, err
+	}
+	base := path.Base(name)
+	for _, fi := range list {
+		if fi.Name == base {
+			return fi, nil
+		}
+	}
+	return nil, os.ErrNotExist
+}
+
+// Invalidate remove the cache of files or directories and their parent directories
+func (r *RemoteFS) Invalidate(names ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		abs := r.Abs(name)
+		delete(r.dirs, path.Dir(abs))
+		for key := range r.dirs {
+			if key == abs || strings.HasPrefix(key, abs+"/") {
+				delete(r.dirs, key)
+			}
+		}
+		for key, entry := range r.contents {
+			if key == abs || strings.HasPrefix(key, abs+"/") {
+				r.contentSize -= int64(len(entry.data))
+				delete(r.contents, key)
+			}
+		}
+	}
+}
+
+// readFile read the entire small file, the cached contents are used if the file is not modified
+func (r *RemoteFS) readFile(name string, fi *pb.FileInfo) ([]byte, error) {
+	abs := r.Abs(name)
+	r.mu.Lock()
+	entry, ok := r.contents[abs]
+	r.mu.Unlock()
+	if ok && entry.mtime == fi.LastModifiedTime && int64(len(entry.data)) == fi.Size {
+		return entry.data, nil
+	}
+	var buf bytes.Buffer
+	if err := r.proxy.DownloadFile(abs, &buf, nil); err != nil {
+		return nil, err
+	}
+	data := buf.Bytes()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if old, ok := r.contents[abs]; ok {
+		r.contentSize -= int64(len(old.data))
+	}
+	// evict the cached contents until there is enough space
+	for key, old := range r.contents {
+		if r.contentSize+int64(len(data)) <= MaxContentCacheSize {
+			break
+		}
+		r.contentSize -= int64(len(old.data))
+		delete(r.contents, key)
+	}
+	r.contents[abs] = contentEntry{data: data, mtime: fi.LastModifiedTime}
+	r.contentSize += int64(len(data))
+	return data, nil
+}
+
+// readRange read length bytes of file from the offset
+func (r *RemoteFS) readRange(name string, off, length int64) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := r.proxy.DownloadFileRange(r.Abs(name), off, length, &buf, nil); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// WriteFile upload the size bytes from reader to a temporary file beside the file and then
+// replace the file with it, so the current contents are kept if the upload fails
+func (r *RemoteFS) WriteFile(name string, reader io.Reader, size int64) (err error) {
+	defer r.Invalidate(name)
+	abs := r.Abs(name)
+	tmp := path.Join(path.Dir(abs), "."+path.Base(abs)+TempFileSuffix)
+	// the empty bytes stream can not be uploaded, so the empty file is created
+	if size == 0 {
+		err = r.proxy.CreateFile(tmp)
+	} else {
+		err = r.proxy.UploadFile(reader, tmp)
+	}
+	if err == nil {
+		err = r.proxy.Move(tmp, abs)
+	}
+	if err != nil {
+		_ = r.proxy.DeleteFile(tmp)
+	}
+	return err
+}
+
+func (r *RemoteFS) Create(name string) error {
+	defer r.Invalidate(name)
+	return r.proxy.CreateFile(r.Abs(name))
+}
+
+func (r *RemoteFS) MkDir(name string) error {
+	defer r.Invalidate(name)
+	return r.proxy.MkDir(r.Abs(name))
+}
+
+// Remove delete the file or remove the directory recursively
+func (r *RemoteFS) Remove(name string, dir bool) error {
+	defer r.Invalidate(name)
+	if dir {
+		return r.proxy.RmDir(r.Abs(name))
+	}
+	return r.proxy.DeleteFile(r.Abs(name))
+}
+
+func (r *RemoteFS) Rename(oldName, newName string) error {
+	defer r.Invalidate(oldName, newName)
+	return r.proxy.Rename(r.Abs(oldName), r.Abs(newName))
+}
+
+func (r *RemoteFS) Copy(oldName, newName string) error {
+	defer r.Invalidate(newName)
+	return r.proxy.Copy(r.Abs(oldName), r.Abs(newName))
+}
+
+func (r *RemoteFS) SetModTime(name string, mtime time.Time) error {
+	defer r.Invalidate(name)
+	return r.proxy.SetLastModified(r.Abs(name), mtime.UnixMilli())
+}
+
+// FileReader read the remote file at any offset, the small file is read entirely and cached,
+// and the large file is read by blocks ahead
+type FileReader struct {
+	fs       *RemoteFS
+	name     string
+	info     *pb.FileInfo
+	mu       sync.Mutex
+	block    []byte
+	blockOff int64
+}
+
+func (r *RemoteFS) NewFileReader(name string, fi *pb.FileInfo) *FileReader {
+	return &FileReader{fs: r, name: name, info: fi}
+}
+
+func (fr *FileReader) Size() int64 {
+	return fr.info.Size
+}
+
+func (fr *FileReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= fr.info.Size {
+		return 0, io.EOF
+	}
+	if fr.info.Size <= SmallFileSize {
+		data, err := fr.fs.readFile(fr.name, fr.info)
+		if err != nil {
+			return 0, err
+		}
+		return readAt(data, 0, p, off)
+	}
+
+	fr.mu.Lock()
+	defer fr.mu.Unlock()
+	if off < fr.blockOff || off+int64(len(p)) > fr.blockOff+int64(len(fr.block)) {
+		length := int64(len(p))
+		if length < ReadAheadSize {
+			length = ReadAheadSize
+		}
+		data, err := fr.fs.readRange(fr.name, off, length)
+		if err != nil {
+			return 0, err
+		}
+		fr.block, fr.blockOff = data, off
+	}
+	return readAt(fr.block, fr.blockOff, p, off)
+}
+
+// readAt copy the data which starts at base to p from the offset
+func readAt(data []byte, base int64, p []byte, off int64) (int, error) {
+	start := off - base
+	if start >= int64(len(data)) {
+		return 0, io.EOF
+	}
+	n := copy(p, data[start:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// FileWriter buffer the written bytes in a temporary file and upload it when flushed.
+// The remote file can not be written partially, so opening an existing file for writing without
+// truncating downloads the whole file, and every flush after writing uploads the whole file again.
+// Appending to or editing a few bytes of a large file costs as much as copying it twice
+type FileWriter struct {
+	fs    *RemoteFS
+	name  string
+	mu    sync.Mutex
+	tmp   *os.File
+	dirty bool
+}
+
+// NewFileWriter create a writer for the file, the current contents are downloaded unless truncate
+func (r *RemoteFS) NewFileWriter(name string, truncate bool) (*FileWriter, error) {
+	tmp, err := os.CreateTemp("", "godroidcli-*")
+	if err != nil {
+		return nil, err
+	}
+	w := &FileWriter{fs: r, name: name, tmp: tmp, dirty: truncate}
+	if !truncate {
+		if _, err = r.Stat(name); err == nil {
+			err = r.proxy.DownloadFile(r.Abs(name), tmp, nil)
+		} else if os.IsNotExist(err) {
+			err, w.dirty = nil, true
+		}
+	}
+	if err != nil {
+		_ = w.remove()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (w *FileWriter) WriteAt(p []byte, off int64) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.dirty = true
+	return w.tmp.WriteAt(p, off)
+}
+
+func (w *FileWriter) ReadAt(p []byte, off int64) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.tmp.ReadAt(p, off)
+}
+
+func (w *FileWriter) Truncate(size int64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.dirty = true
+	return w.tmp.Truncate(size)
+}
+
+func (w *FileWriter) Size() (int64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	info, err := w.tmp.Stat()
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Flush upload the temporary file if it is modified
+func (w *FileWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.dirty {
+		return nil
+	}
+	info, err := w.tmp.Stat()
+	if err != nil {
+		return err
+	}
+	if err = w.fs.WriteFile(w.name, io.NewSectionReader(w.tmp, 0, info.Size()), info.Size()); err != nil {
+		return err
+	}
+	w.dirty = false
+	return nil
+}
+
+// Close flush and remove the temporary file
+func (w *FileWriter) Close() error {
+	err := w.Flush()
+	if e := w.remove(); err == nil {
+		err = e
+	}
+	return err
+}
+
+func (w *FileWriter) remove() error {
+	_ = w.tmp.Close()
+	return os.Remove(w.tmp.Name())
+}
@@ -0,0 +1,202 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package vfs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/josexy/godroidcli/android/cli/stream"
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+)
+
+// fakeFileSystem keep the remote files in memory and count the calls of ListDir
+type fakeFileSystem struct {
+	internal.IFileSystem
+	files     map[string][]byte
+	dirs      map[string]bool
+	listCalls int
+	// the upload and create of files fail
+	failWrite bool
+}
+
+func newFakeFileSystem() *fakeFileSystem {
+	return &fakeFileSystem{files: make(map[string][]byte), dirs: map[string]bool{"/sdcard": true}}
+}
+
+func (f *fakeFileSystem) ListDir(dir, _ string) (*pb.FileInfoList, error) {
+	f.listCalls++
+	list := &pb.FileInfoList{}
+	for name := range f.dirs {
+		if name != dir && path.Dir(name) == dir {
+			list.Values = append(list.Values, &pb.FileInfo{Name: name, Dir: true})
+		}
+	}
+	for name, data := range f.files {
+		if path.Dir(name) == dir {
+			list.Values = append(list.Values, &pb.FileInfo{Name: name, Size: int64(len(data))})
+		}
+	}
+	sort.Slice(list.Values, func(i, j int) bool { return list.Values[i].Name < list.Values[j].Name })
+	return list, nil
+}
+
+func (f *fakeFileSystem) DownloadFile(src string, writer io.Writer, fn stream.ProgressCallback) error {
+	return f.DownloadFileRange(src, 0, 0, writer, fn)
+}
+
+func (f *fakeFileSystem) DownloadFileRange(src string, offset, length int64, writer io.Writer, _ stream.ProgressCallback) error {
+	data := f.files[src][offset:]
+	if length > 0 && length < int64(len(data)) {
+		data = data[:length]
+	}
+	_, err := writer.Write(data)
+	return err
+}
+
+func (f *fakeFileSystem) UploadFile(reader io.Reader, dest string) error {
+	data, err := io.ReadAll(reader)
+	if f.failWrite {
+		// the partial contents are left
+		data, err = data[:len(data)/2], errors.New("upload failed")
+	}
+	f.files[dest] = data
+	return err
+}
+
+func (f *fakeFileSystem) CreateFile(file string) error {
+	if f.failWrite {
+		return errors.New("create failed")
+	}
+	f.files[file] = nil
+	return nil
+}
+
+func (f *fakeFileSystem) Move(src, dest string) error {
+	data, ok := f.files[src]
+	if !ok {
+		return os.ErrNotExist
+	}
+	delete(f.files, src)
+	f.files[dest] = data
+	return nil
+}
+
+func (f *fakeFileSystem) DeleteFile(file string) error {
+	delete(f.files, file)
+	return nil
+}
+
+func (f *fakeFileSystem) MkDir(dir string) error {
+	f.dirs[dir] = true
+	return nil
+}
+
+func newTestRemoteFS() (*RemoteFS, *fakeFileSystem) {
+	fake := newFakeFileSystem()
+	return NewRemoteFS(&internal.SessionProxy{IFileSystem: fake}, "/sdcard"), fake
+}
+
+func TestRemoteFS_Cache(t *testing.T) {
+	r, fake := newTestRemoteFS()
+	fake.files["/sdcard/1.txt"] = []byte("hello")
+
+	for i := 0; i < 3; i++ {
+		if fi, err := r.Stat("1.txt"); err != nil || fi.Size != 5 {
+			t.Fatalf("got %v, %v", fi, err)
+		}
+	}
+	if fake.listCalls != 1 {
+		t.Fatalf("got %d calls of ListDir, want 1", fake.listCalls)
+	}
+	// the cache is invalidated after the directory is changed
+	if err := r.MkDir("sub"); err != nil {
+		t.Fatal(err)
+	}
+	if fi, err := r.Stat("sub"); err != nil || !fi.Dir {
+		t.Fatalf("got %v, %v", fi, err)
+	}
+	if fake.listCalls != 2 {
+		t.Fatalf("got %d calls of ListDir, want 2", fake.listCalls)
+	}
+}
+
+func TestRemoteFS_ReadWrite(t *testing.T) {
+	r, fake := newTestRemoteFS()
+	large := bytes.Repeat([]byte("0123456789"), SmallFileSize/5)
+	fake.files["/sdcard/large.bin"] = large
+
+	fi, err := r.Stat("large.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := r.NewFileReader("large.bin", fi)
+	got, err := io.ReadAll(io.NewSectionReader(reader, 0, reader.Size()))
+	if err != nil || !bytes.Equal(got, large) {
+		t.Fatalf("got %d bytes, want %d bytes: %v", len(got), len(large), err)
+	}
+
+	w, err := r.NewFileWriter("large.bin", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = w.WriteAt([]byte("abc"), 10); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	want := append(append(append([]byte{}, large[:10]...), "abc"...), large[13:]...)
+	if !bytes.Equal(fake.files["/sdcard/large.bin"], want) {
+		t.Fatal("the written file is not uploaded")
+	}
+
+	// the empty file is recreated
+	w, err = r.NewFileWriter("large.bin", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if data, ok := fake.files["/sdcard/large.bin"]; !ok || len(data) != 0 {
+		t.Fatalf("got %d bytes, want empty file", len(data))
+	}
+	if len(fake.files) != 1 {
+		t.Fatalf("got files %d, want the temporary files are renamed", len(fake.files))
+	}
+}
+
+func TestRemoteFS_WriteFileFailed(t *testing.T) {
+	r, fake := newTestRemoteFS()
+	fake.files["/sdcard/1.txt"] = []byte("hello")
+	fake.failWrite = true
+
+	for _, data := range []string{"", "hello world"} {
+		if err := r.WriteFile("1.txt", strings.NewReader(data), int64(len(data))); err == nil {
+			t.Fatal("got nil error, want the write error")
+		}
+		if got := string(fake.files["/sdcard/1.txt"]); got != "hello" || len(fake.files) != 1 {
+			t.Fatalf("got %q and %d files, want the file is kept", got, len(fake.files))
+		}
+	}
+}
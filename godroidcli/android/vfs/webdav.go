@@ -0,0 +1,255 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package vfs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"time"
+
+	"github.com/josexy/godroidcli/android/internal"
+	pb "github.com/josexy/godroidcli/protobuf"
+	"golang.org/x/net/webdav"
+)
+
+// DavPrefix the url prefix of WebDAV handler
+const DavPrefix = "/dav"
+
+// DavMethods the http methods which are handled by WebDAV handler
+var DavMethods = []string{
+	"OPTIONS", "GET", "HEAD", "POST", "PUT", "DELETE",
+	"MKCOL", "COPY", "MOVE", "LOCK", "UNLOCK", "PROPFIND", "PROPPATCH",
+}
+
+var errIsDir = errors.New("is a directory")
+
+// NewDavHandler create a WebDAV handler which shares the remote directory
+func NewDavHandler(proxy *internal.SessionProxy, root string) *webdav.Handler {
+	return &webdav.Handler{
+		Prefix:     DavPrefix,
+		FileSystem: &DavFileSystem{remote: NewRemoteFS(proxy, root)},
+		LockSystem: webdav.NewMemLS(),
+	}
+}
+
+// fileInfo convert the remote file information to fs.FileInfo
+type fileInfo struct {
+	*pb.FileInfo
+	// the size of file being written, which overrides the remote one
+	size int64
+}
+
+func newFileInfo(fi *pb.FileInfo) fileInfo {
+	return fileInfo{FileInfo: fi, size: fi.Size}
+}
+
+func (fi fileInfo) Name() string { return fi.FileInfo.Name }
+
+func (fi fileInfo) Size() int64 { return fi.size }
+
+func (fi fileInfo) Mode() fs.FileMode {
+	if fi.FileInfo.Dir {
+		return fs.ModeDir | 0755
+	}
+	return 0644
+}
+
+func (fi fileInfo) ModTime() time.Time { return time.UnixMilli(fi.LastModifiedTime) }
+
+func (fi fileInfo) IsDir() bool { return fi.FileInfo.Dir }
+
+func (fi fileInfo) Sys() interface{} { return fi.FileInfo }
+
+// DavFileSystem map the WebDAV operations onto the remote file system
+type DavFileSystem struct {
+	remote *RemoteFS
+}
+
+var _ webdav.FileSystem = (*DavFileSystem)(nil)
+
+func (d *DavFileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	if _, err := d.remote.Stat(name); err == nil {
+		return os.ErrExist
+	}
+	// the parent directory must exist
+	if _, err := d.remote.Stat(path.Dir(path.Clean("/" + name))); err != nil {
+		return os.ErrNotExist
+	}
+	return d.remote.MkDir(name)
+}
+
+func (d *DavFileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	fi, err := d.remote.Stat(name)
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_TRUNC) == 0 {
+		if err != nil {
+			return nil, err
+		}
+		f := &davFile{remote: d.remote, name: name, info: fi}
+		if !fi.Dir {
+			f.reader = d.remote.NewFileReader(name, fi)
+		}
+		return f, nil
+	}
+
+	switch {
+	case err == nil && fi.Dir:
+		return nil, errIsDir
+	case err == nil && flag&os.O_EXCL != 0:
+		return nil, os.ErrExist
+	case err != nil && flag&os.O_CREATE == 0:
+		return nil, err
+	}
+	writer, err := d.remote.NewFileWriter(name, flag&os.O_TRUNC != 0)
+	if err != nil {
+		return nil, err
+	}
+	if fi == nil {
+		fi = &pb.FileInfo{Name: path.Base(name), LastModifiedTime: time.Now().UnixMilli()}
+	}
+	return &davFile{remote: d.remote, name: name, info: fi, writer: writer}, nil
+}
+
+func (d *DavFileSystem) RemoveAll(ctx context.Context, name string) error {
+	fi, err := d.remote.Stat(name)
+	if err != nil {
+		return err
+	}
+	return d.remote.Remove(name, fi.Dir)
+}
+
+func (d *DavFileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	return d.remote.Rename(oldName, newName)
+}
+
+func (d *DavFileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	fi, err := d.remote.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	return newFileInfo(fi), nil
+}
+
+// davFile the opened file or directory, which reads from the remote file or writes to the temporary file
+type davFile struct {
+	remote *RemoteFS
+	name   string
+	info   *pb.FileInfo
+	reader *FileReader
+	writer *FileWriter
+	off    int64
+	// the position of directory entries which are read
+	pos int
+}
+
+var _ webdav.File = (*davFile)(nil)
+
+func (f *davFile) size() (int64, error) {
+	if f.writer != nil {
+		return f.writer.Size()
+	}
+	return f.info.Size, nil
+}
+
+func (f *davFile) Read(p []byte) (n int, err error) {
+	switch {
+	case f.writer != nil:
+		n, err = f.writer.ReadAt(p, f.off)
+	case f.reader != nil:
+		n, err = f.reader.ReadAt(p, f.off)
+	default:
+		return 0, errIsDir
+	}
+	f.off += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return
+}
+
+func (f *davFile) Write(p []byte) (int, error) {
+	if f.writer == nil {
+		return 0, os.ErrPermission
+	}
+	n, err := f.writer.WriteAt(p, f.off)
+	f.off += int64(n)
+	return n, err
+}
+
+func (f *davFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.off
+	case io.SeekEnd:
+		size, err := f.size()
+		if err != nil {
+			return 0, err
+		}
+		offset += size
+	}
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+	f.off = offset
+	return offset, nil
+}
+
+func (f *davFile) Readdir(count int) ([]fs.FileInfo, error) {
+	if !f.info.Dir {
+		return nil, os.ErrInvalid
+	}
+	list, err := f.remote.ListDir(f.name)
+	if err != nil {
+		return nil, err
+	}
+	if f.pos > len(list) {
+		f.pos = len(list)
+	}
+	list = list[f.pos:]
+	if count > 0 {
+		if len(list) == 0 {
+			return nil, io.EOF
+		}
+		if count < len(list) {
+			list = list[:count]
+		}
+	}
+	f.pos += len(list)
+	infos := make([]fs.FileInfo, 0, len(list))
+	for _, fi := range list {
+		infos = append(infos, newFileInfo(fi))
+	}
+	return infos, nil
+}
+
+func (f *davFile) Stat() (fs.FileInfo, error) {
+	size, err := f.size()
+	if err != nil {
+		return nil, err
+	}
+	info := newFileInfo(f.info)
+	info.size = size
+	return info, nil
+}
+
+func (f *davFile) Close() error {
+	if f.writer != nil {
+		return f.writer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package vfs
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/josexy/godroidcli/android/internal"
+)
+
+func TestDavHandler(t *testing.T) {
+	fake := newFakeFileSystem()
+	server := httptest.NewServer(NewDavHandler(&internal.SessionProxy{IFileSystem: fake}, "/sdcard"))
+	defer server.Close()
+
+	do := func(method, name, body string, want int) *http.Response {
+		req, _ := http.NewRequest(method, server.URL+DavPrefix+name, strings.NewReader(body))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != want {
+			t.Fatalf("%s %s: got status %d, want %d", method, name, resp.StatusCode, want)
+		}
+		return resp
+	}
+
+	do("MKCOL", "/docs", "", http.StatusCreated)
+	if !fake.dirs["/sdcard/docs"] {
+		t.Fatal("the directory is not created")
+	}
+	do("PUT", "/docs/1.txt", "hello", http.StatusCreated)
+	if string(fake.files["/sdcard/docs/1.txt"]) != "hello" {
+		t.Fatalf("got %q, want %q", fake.files["/sdcard/docs/1.txt"], "hello")
+	}
+
+	resp := do("GET", "/docs/1.txt", "", http.StatusOK)
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(data) != "hello" {
+		t.Fatalf("got %q, want %q", data, "hello")
+	}
+
+	resp = do("PROPFIND", "/docs/", "", http.StatusMultiStatus)
+	data, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(data), "/dav/docs/1.txt") {
+		t.Fatalf("the file is not listed: %s", data)
+	}
+
+	do("DELETE", "/docs/1.txt", "", http.StatusNoContent)
+	if _, ok := fake.files["/sdcard/docs/1.txt"]; ok {
+		t.Fatal("the file is not deleted")
+	}
+}
@@ -112,6 +112,65 @@ func (node *Node) Group() (list []string) {
 	return
 }
 
+// PipeLine rebuild the pipe part of command line from the pipe node
+// for example: | grep "hello world" | export csv 1.csv
+func (node *Node) PipeLine() string {
+	var list []string
+	for n := node; n != nil && n.IsPipe(); n = n.Right {
+		list = append(list, "|")
+		if n.HasLeft() {
+			for _, s := range n.Left.Group() {
+				list = append(list, Quote(s))
+			}
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+// HasStage report whether there is a stage with the name in the pipe, such as "| export 1.txt"
+func (node *Node) HasStage(name string) bool {
+	for n := node; n != nil && n.IsPipe(); n = n.Right {
+		if n.HasLeft() && n.Left.tok.String() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Format return the output format if there is a "| json" or "| yaml" stage in the pipe
+func (node *Node) Format() string {
+	for n := node; n != nil && n.IsPipe(); n = n.Right {
+		if n.HasLeft() {
+			switch name := n.Left.tok.String(); name {
+			case Json, Yaml:
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// ExpandVars replace the variables in every string of the tree with their values,
+// the values are never parsed as pipes or quotes since the line has been parsed
+func (node *Node) ExpandVars(vars map[string]string) {
+	if node == nil {
+		return
+	}
+	if node.tok != nil && node.tok.typ == tokenString {
+		node.tok.name = []byte(ExpandVars(string(node.tok.name), vars))
+	}
+	node.Left.ExpandVars(vars)
+	node.Right.ExpandVars(vars)
+}
+
+// Quote wrap the string with quotes if it can not be parsed as a single token
+func Quote(s string) string {
+	if s == "" || strings.IndexFunc(s, unicode.IsSpace) >= 0 || strings.Contains(s, "|") {
+		return `"` + s + `"`
+	}
+	return s
+}
+
 const (
 	startTagState = iota + 1
 	startStringState
@@ -273,12 +332,12 @@ func (cmd *CmdParser) parseOp() *Node {
 	switch name {
 	case Export:
 		left.Left = cmd.parseType()
-	case IGrep, Grep, Print:
+	case IGrep, Grep, Print, Json, Yaml:
 		left.Left = cmd.parseString()
 	default:
 		panic(fmt.Errorf("can not parse identifier: [%s]", name))
 	}
-	if name != Print && left.Left == nil {
+	if name != Print && name != Json && name != Yaml && left.Left == nil {
 		panic(status.ErrProvideParams)
 	}
 	return left
@@ -65,3 +65,19 @@ func TestCmdParser_BAD_Parse(t *testing.T) {
 	s = `cmd pm package | grep xx yy zz | print |`
 	testCmdParser(s)
 }
+
+func TestNode_Format(t *testing.T) {
+	for s, want := range map[string]string{
+		`cmd device battery`:                   "",
+		`cmd device battery | json`:            Json,
+		`cmd device battery | yaml | grep lev`: Yaml,
+		`cmd device battery | grep level`:      "",
+	} {
+		if err := p.Parse(s); err != nil {
+			t.Fatal(err)
+		}
+		if got := p.Root().Right.Format(); got != want {
+			t.Fatalf("%s: got %q, want %q", s, got, want)
+		}
+	}
+}
@@ -34,6 +34,8 @@ import (
 	- html
 	- markdown
 	- "output file"
+- Json		"json"
+- Yaml		"yaml"
 */
 
 const (
@@ -44,6 +46,8 @@ const (
 	Csv      = "csv"
 	Markdown = "markdown"
 	Html     = "html"
+	Json     = "json"
+	Yaml     = "yaml"
 )
 
 var emptyPipeStream = PipeStream{}
@@ -120,6 +124,8 @@ func (sp *PipeStream) Filter(node *Node) IPipeStream {
 				}
 			case Print:
 				pipe = pipe.Print()
+			case Json, Yaml:
+				// the format of proto message is handled by resolvers
 			default:
 				// ignored panic
 			}
@@ -15,7 +15,9 @@
 package filter
 
 import (
+	"bytes"
 	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/josexy/godroidcli/util"
@@ -100,3 +102,26 @@ func BenchmarkStreamPipe_Grep(b *testing.B) {
 		pipe.Grep([]byte("copyright"))
 	}
 }
+
+func TestPipeWriter(t *testing.T) {
+	output := util.StdOutput
+	defer func() { util.StdOutput = output }()
+	buf := bytes.NewBuffer(nil)
+	util.StdOutput = buf
+
+	p := NewCmdParser()
+	if err := p.Parse("cmd fs tail -f app.log | grep ERROR"); err != nil {
+		t.Fatal(err)
+	}
+	w := NewPipeWriter(p.Root().Right)
+	for _, chunk := range []string{"INFO start\nERROR fa", "iled\nINFO retry\n", "ERROR again"} {
+		_, _ = w.Write([]byte(chunk))
+	}
+	if !strings.Contains(buf.String(), "failed") || strings.Contains(buf.String(), "again") {
+		t.Fatalf("got %q, want only the complete lines", buf.String())
+	}
+	_ = w.Close()
+	if strings.Contains(buf.String(), "INFO") || !strings.Contains(buf.String(), "again") {
+		t.Fatalf("got %q, want the lines matching ERROR", buf.String())
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package filter
+
+import "bytes"
+
+// PipeWriter feed the complete lines written to it into the pipeline as they arrive,
+// so that the pipeline works on the growing output, such as "fs tail -f app.log | grep ERROR"
+type PipeWriter struct {
+	node *Node
+	buf  []byte
+	// the exported file is overwritten by each stage, so the whole output is piped once when closing
+	batch bool
+}
+
+func NewPipeWriter(node *Node) *PipeWriter {
+	return &PipeWriter{node: node, batch: node.HasStage(Export)}
+}
+
+func (w *PipeWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	if w.batch {
+		return len(p), nil
+	}
+	if i := bytes.LastIndexByte(w.buf, '\n'); i >= 0 {
+		PipeOutput(w.buf[:i], w.node)
+		w.buf = append(w.buf[:0], w.buf[i+1:]...)
+	}
+	return len(p), nil
+}
+
+// Close pipe the remaining bytes which are not terminated by a newline
+func (w *PipeWriter) Close() error {
+	if len(w.buf) > 0 {
+		PipeOutput(bytes.TrimSuffix(w.buf, []byte{'\n'}), w.node)
+		w.buf = nil
+	}
+	return nil
+}
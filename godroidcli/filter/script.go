@@ -0,0 +1,248 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package filter
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/josexy/godroidcli/status"
+)
+
+/*
+a script file is made up of command lines and some simple control statements,
+all command lines are still parsed by CmdParser when they are executed.
+
+# comment
+set DIR /storage/emulated/0/Download
+cmd fs list $DIR | grep apk
+for pkg in com.android.chrome ${APP}
+	cmd pm package $pkg
+end
+cmd fs mkdir $DIR/tmp
+if ok
+	cmd fs upload ./1.txt $DIR/tmp/
+else
+	cmd fs pwd
+end
+*/
+
+const (
+	ScriptComment = "#"
+	ScriptFor     = "for"
+	ScriptIn      = "in"
+	ScriptIf      = "if"
+	ScriptElse    = "else"
+	ScriptEnd     = "end"
+	ScriptOk      = "ok"
+	ScriptFail    = "fail"
+)
+
+// $NAME or ${NAME}
+var varPattern = regexp.MustCompile(`\$\{([A-Za-z0-9_]+)\}|\$([A-Za-z0-9_]+)`)
+
+type stmtType byte
+
+const (
+	stmtCommand stmtType = iota + 1
+	stmtFor
+	stmtIf
+)
+
+type (
+	statement struct {
+		typ  stmtType
+		no   int    // line number
+		line string // command line, loop items or if condition
+		name string // loop variable name
+		body []*statement
+		alt  []*statement // else branch
+	}
+
+	Script struct {
+		stmts []*statement
+	}
+
+	// ScriptExecutor execute the command lines and hold the variables of script
+	ScriptExecutor interface {
+		Execute(string) error
+		SetVar(string, string)
+		ExpandVars(string) string
+	}
+)
+
+// ExpandVars replace $NAME and ${NAME} with the variable value,
+// the undefined variables are left as they are
+func ExpandVars(s string, vars map[string]string) string {
+	if !strings.Contains(s, "$") {
+		return s
+	}
+	return varPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := varPattern.FindStringSubmatch(m)
+		name := sub[1]
+		if name == "" {
+			name = sub[2]
+		}
+		if value, ok := vars[name]; ok {
+			return value
+		}
+		return m
+	})
+}
+
+// ParseScript read all lines and build the statements of script
+func ParseScript(r io.Reader) (*Script, error) {
+	root := &statement{}
+	// the blocks which are not ended yet
+	stack := []*statement{root}
+	// whether the if block is in else branch
+	inElse := map[*statement]bool{}
+
+	appendStmt := func(s *statement) {
+		top := stack[len(stack)-1]
+		if inElse[top] {
+			top.alt = append(top.alt, s)
+		} else {
+			top.body = append(top.body, s)
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	for no := 1; scanner.Scan(); no++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, ScriptComment) {
+			continue
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case ScriptFor:
+			// for NAME in ITEMS...
+			if len(fields) < 3 || fields[2] != ScriptIn {
+				return nil, fmt.Errorf("line %d: usage: for NAME in ITEMS", no)
+			}
+			s := &statement{typ: stmtFor, no: no, name: fields[1],
+				line: strings.Join(fields[3:], " ")}
+			appendStmt(s)
+			stack = append(stack, s)
+		case ScriptIf:
+			// if ok|fail
+			if len(fields) != 2 || (fields[1] != ScriptOk && fields[1] != ScriptFail) {
+				return nil, fmt.Errorf("line %d: usage: if ok|fail", no)
+			}
+			s := &statement{typ: stmtIf, no: no, line: fields[1]}
+			appendStmt(s)
+			stack = append(stack, s)
+		case ScriptElse:
+			top := stack[len(stack)-1]
+			if top.typ != stmtIf || inElse[top] {
+				return nil, fmt.Errorf("line %d: unexpected %q", no, ScriptElse)
+			}
+			inElse[top] = true
+		case ScriptEnd:
+			if len(stack) == 1 {
+				return nil, fmt.Errorf("line %d: unexpected %q", no, ScriptEnd)
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			appendStmt(&statement{typ: stmtCommand, no: no, line: line})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(stack) > 1 {
+		top := stack[len(stack)-1]
+		return nil, fmt.Errorf("line %d: missing %q", top.no, ScriptEnd)
+	}
+	return &Script{stmts: root.body}, nil
+}
+
+type scriptRunner struct {
+	executor ScriptExecutor
+	// the error state of the previous command
+	last error
+	// the callback is called when a command line failed
+	onError func(int, string, error)
+	failed  bool
+}
+
+func (r *scriptRunner) run(stmts []*statement) {
+	for _, s := range stmts {
+		switch s.typ {
+		case stmtCommand:
+			r.last = r.executor.Execute(s.line)
+			if r.last != nil {
+				r.failed = true
+				if r.onError != nil {
+					r.onError(s.no, s.line, r.last)
+				}
+			}
+		case stmtFor:
+			for _, item := range strings.Fields(r.executor.ExpandVars(s.line)) {
+				r.executor.SetVar(s.name, item)
+				r.run(s.body)
+			}
+		case stmtIf:
+			if (s.line == ScriptOk) == (r.last == nil) {
+				r.run(s.body)
+			} else {
+				r.run(s.alt)
+			}
+		}
+	}
+}
+
+// Run execute all statements of script, the execution will continue even though some
+// commands failed, but status.ErrCommandFailed is returned if any command failed
+func (s *Script) Run(executor ScriptExecutor, onError func(no int, line string, err error)) error {
+	runner := &scriptRunner{executor: executor, onError: onError}
+	runner.run(s.stmts)
+	if runner.failed {
+		return status.ErrCommandFailed
+	}
+	return nil
+}
+
+// $1 or ${1}
+var argPattern = regexp.MustCompile(`\$\{[0-9]+\}|\$[0-9]+`)
+
+// ExpandArgs replace the positional parameters $1, $2 ... ${N} of command lines with args.
+// if there is no positional parameter in lines, args are appended to the last line
+func ExpandArgs(lines []string, args []string) []string {
+	quoted := make([]string, len(args))
+	positional := make(map[string]string, len(args))
+	for i, arg := range args {
+		quoted[i] = Quote(arg)
+		positional[strconv.Itoa(i+1)] = quoted[i]
+	}
+
+	var found bool
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		found = found || argPattern.MatchString(line)
+		result[i] = argPattern.ReplaceAllStringFunc(line, func(m string) string {
+			return positional[strings.Trim(m, "${}")]
+		})
+	}
+	if !found && len(result) > 0 && len(quoted) > 0 {
+		last := len(result) - 1
+		result[last] = strings.Join(append([]string{result[last]}, quoted...), " ")
+	}
+	return result
+}
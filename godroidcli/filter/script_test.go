@@ -0,0 +1,152 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testExecutor struct {
+	vars  map[string]string
+	lines []string
+}
+
+func (e *testExecutor) Execute(line string) error {
+	line = e.ExpandVars(line)
+	e.lines = append(e.lines, line)
+	if strings.HasPrefix(line, "bad") {
+		return errors.New("bad command")
+	}
+	return nil
+}
+
+func (e *testExecutor) SetVar(name, value string) {
+	e.vars[name] = value
+}
+
+func (e *testExecutor) ExpandVars(s string) string {
+	return ExpandVars(s, e.vars)
+}
+
+func TestExpandVars(t *testing.T) {
+	vars := map[string]string{"DIR": "/sdcard", "1": "a.txt"}
+	got := ExpandVars(`cmd fs list $DIR/${1} $UNDEFINED "$DIR"`, vars)
+	want := `cmd fs list /sdcard/a.txt $UNDEFINED "/sdcard"`
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestScript_Run(t *testing.T) {
+	s := `
+# comment
+for pkg in com.a $APPS
+	cmd pm package $pkg
+end
+bad command
+if ok
+	cmd unreachable
+else
+	cmd fs pwd
+end
+cmd device info
+if ok
+	cmd fs list $pkg
+end
+`
+	e := &testExecutor{vars: map[string]string{"APPS": "com.b com.c"}}
+	script, err := ParseScript(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var failed []int
+	err = script.Run(e, func(no int, line string, err error) {
+		failed = append(failed, no)
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	want := []string{
+		"cmd pm package com.a",
+		"cmd pm package com.b",
+		"cmd pm package com.c",
+		"bad command",
+		"cmd fs pwd",
+		"cmd device info",
+		"cmd fs list com.c",
+	}
+	if !reflect.DeepEqual(e.lines, want) {
+		t.Fatalf("got %q, want %q", e.lines, want)
+	}
+	if !reflect.DeepEqual(failed, []int{6}) {
+		t.Fatalf("got failed lines %v", failed)
+	}
+}
+
+func TestScript_BAD_Parse(t *testing.T) {
+	for _, s := range []string{
+		"for x\nend",
+		"if maybe\nend",
+		"else",
+		"end",
+		"for x in a b\ncmd fs pwd",
+		"if ok\nelse\nelse\nend",
+	} {
+		if _, err := ParseScript(strings.NewReader(s)); err == nil {
+			t.Errorf("expected error: %q", s)
+		} else {
+			t.Log(err)
+		}
+	}
+}
+
+func TestExpandArgs(t *testing.T) {
+	got := ExpandArgs([]string{"cmd fs list"}, []string{"/sdcard/My Docs"})
+	if want := []string{`cmd fs list "/sdcard/My Docs"`}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	got = ExpandArgs([]string{"cmd fs cd $1", "cmd fs download ${2} ./"}, []string{"/sdcard", "1.txt"})
+	if want := []string{"cmd fs cd /sdcard", "cmd fs download 1.txt ./"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestNode_ExpandVars(t *testing.T) {
+	p := NewCmdParser()
+	if err := p.Parse(`cmd fs list $DIR | grep $PATTERN`); err != nil {
+		t.Fatal(err)
+	}
+	root := p.Root()
+	root.ExpandVars(map[string]string{"DIR": `/sdcard/a b|c`, "PATTERN": `"x"`})
+	if got, want := root.Group(), []string{"cmd", "fs", "list", `/sdcard/a b|c`}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := root.Right.PipeLine(), `| grep "x"`; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestNode_PipeLine(t *testing.T) {
+	p := NewCmdParser()
+	if err := p.Parse(`ls /sdcard | grep "hello world" | export csv 1.csv`); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := p.Root().Right.PipeLine(), `| grep "hello world" | export csv 1.csv`; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -16,6 +16,8 @@ package main
 
 import (
 	"flag"
+
+	"github.com/josexy/godroidcli/android/cli"
 )
 
 // this module is used for creating connection and opening a session quickly
@@ -24,14 +26,45 @@ import (
 // > godroidcli -device SERIAL_NUMBER:PORT
 // connect to android server by TCP/IP
 // > godroidcli -address IP:PORT
+// connect to android server over TLS
+// > godroidcli -address IP:PORT -tls
+// connect to android server with pre-shared token
+// > godroidcli -address IP:PORT -token TOKEN
+// send the token without TLS to android server over network
+// > godroidcli -address IP:PORT -token TOKEN -insecure-token
+// connect to android server with the saved profile
+// > godroidcli -profile NAME
+// output the results of resolver commands as json or yaml
+// > godroidcli -device SERIAL_NUMBER:PORT -output json -c "cmd device battery"
+// execute a command line and exit without entering interactive shell
+// > godroidcli -device SERIAL_NUMBER:PORT -c "cmd device battery"
+// execute all command lines of script file and exit
+// > godroidcli -device SERIAL_NUMBER:PORT -f script.gdc
+// mount the device storage as a local file system on Linux
+// > godroidcli -device SERIAL_NUMBER:PORT mount MOUNTPOINT [REMOTE_DIR]
 var (
 	device  string
 	address string
+	command string
+	script  string
+	useTLS  bool
+	token   string
+	// allow sending the token without TLS
+	insecureToken bool
+	profile       string
+	output        string
 )
 
 func init() {
 	flag.StringVar(&device, "device", "", "android device serial number")
 	flag.StringVar(&address, "address", "", "android device ip address and port")
+	flag.StringVar(&command, "c", "", "execute command line in non-interactive mode")
+	flag.StringVar(&script, "f", "", "execute script file in non-interactive mode")
+	flag.BoolVar(&useTLS, "tls", false, "connect to android server over TLS")
+	flag.StringVar(&token, "token", "", "pre-shared token for authentication")
+	flag.BoolVar(&insecureToken, "insecure-token", false, "allow sending the token without TLS over network")
+	flag.StringVar(&profile, "profile", "", "connect to android server with the saved profile")
+	flag.StringVar(&output, "output", "", "output format of commands: table, json or yaml")
 }
 
 func GetDeviceValue() (string, bool) {
@@ -48,6 +81,54 @@ func GetAddressValue() (string, bool) {
 	return address, true
 }
 
+func GetCommandValue() (string, bool) {
+	if command == "" {
+		return command, false
+	}
+	return command, true
+}
+
+func GetScriptValue() (string, bool) {
+	if script == "" {
+		return script, false
+	}
+	return script, true
+}
+
+func GetTLSValue() bool {
+	return useTLS
+}
+
+func GetTokenValue() string {
+	return token
+}
+
+func GetInsecureTokenValue() bool {
+	return insecureToken
+}
+
+func GetProfileValue() (string, bool) {
+	if profile == "" {
+		return profile, false
+	}
+	return profile, true
+}
+
+func GetOutputValue() (string, bool) {
+	if output == "" {
+		return output, false
+	}
+	return output, true
+}
+
+// GetMountArgs the arguments of mount command after the flags
+func GetMountArgs() ([]string, bool) {
+	if args := flag.Args(); len(args) > 0 && args[0] == cli.MountCommand {
+		return args[1:], true
+	}
+	return nil, false
+}
+
 func ParseCommand() {
 	flag.Parse()
 }
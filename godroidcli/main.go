@@ -15,6 +15,8 @@
 package main
 
 import (
+	"os"
+
 	"github.com/josexy/godroidcli/android/cli"
 	"github.com/josexy/godroidcli/util"
 )
@@ -22,14 +24,29 @@ import (
 func main() {
 
 	ParseCommand()
+	if _, ok := GetCommandValue(); ok {
+		util.LogToStderr()
+	} else if _, ok = GetScriptValue(); ok {
+		util.LogToStderr()
+	}
 
 	console := cli.NewConsole()
+	if value, ok := GetOutputValue(); ok {
+		if err := console.SetOutput(value); err != nil {
+			util.FatalBy(err)
+		}
+	}
+	opts := cli.SessionOptions{TLS: GetTLSValue(), Token: GetTokenValue(), InsecureToken: GetInsecureTokenValue()}
 
-	if value, ok := GetDeviceValue(); ok {
+	if value, ok := GetProfileValue(); ok {
+		if err := console.NewSessionByProfile(value, opts); err != nil {
+			util.FatalBy(err)
+		}
+	} else if value, ok := GetDeviceValue(); ok {
 		if name, port, err := util.Split(value); err != nil {
 			util.FatalBy(err)
 		} else {
-			if err := console.NewSessionBy(name, port, true); err != nil {
+			if err := console.NewSessionBy(name, port, true, opts); err != nil {
 				util.FatalBy(err)
 			}
 		}
@@ -37,10 +54,19 @@ func main() {
 		if name, port, err := util.Split(value); err != nil {
 			util.FatalBy(err)
 		} else {
-			if err := console.NewSessionBy(name, port, false); err != nil {
+			if err := console.NewSessionBy(name, port, false, opts); err != nil {
 				util.FatalBy(err)
 			}
 		}
 	}
+
+	// non-interactive mode
+	if args, ok := GetMountArgs(); ok {
+		os.Exit(console.Mount(args))
+	} else if value, ok := GetCommandValue(); ok {
+		os.Exit(console.RunCommand(value))
+	} else if value, ok := GetScriptValue(); ok {
+		os.Exit(console.RunScript(value))
+	}
 	console.Run()
 }
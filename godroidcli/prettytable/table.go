@@ -311,6 +311,14 @@ func (p *PrettyTable) AddRows(rows Rows) {
 	p.rows = append(p.rows, rows...)
 }
 
+func (p *PrettyTable) GetHeader() Header {
+	return p.header
+}
+
+func (p *PrettyTable) GetRows() Rows {
+	return p.rows
+}
+
 func (p *PrettyTable) GetRow(index int) (row Row) {
 	if index >= 0 && index < len(p.rows) {
 		row = p.rows[index]
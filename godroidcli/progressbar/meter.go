@@ -0,0 +1,138 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package progressbar
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Meter calculate the transfer rate, elapsed time and remaining time of a transfer,
+// it is safe for concurrent use so that the progress can be queried while transferring
+type Meter struct {
+	mu       sync.Mutex
+	present  int64
+	total    int64
+	delta    int64
+	rate     float64 // bytes per second
+	start    time.Time
+	lastTime time.Time
+}
+
+func NewMeter() *Meter {
+	now := time.Now()
+	return &Meter{start: now, lastTime: now}
+}
+
+// Update record the number of bytes transferred and the total size
+func (m *Meter) Update(present, total int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if present > m.present {
+		m.delta += present - m.present
+	}
+	m.present, m.total = present, total
+	m.calcRate()
+}
+
+// calcRate refresh the transfer rate at most once per second
+func (m *Meter) calcRate() {
+	now := time.Now()
+	if d := now.Sub(m.lastTime); d >= time.Second {
+		m.rate = float64(m.delta) / d.Seconds()
+		m.delta = 0
+		m.lastTime = now
+	}
+}
+
+// Progress the number of bytes transferred and the total size
+func (m *Meter) Progress() (present, total int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.present, m.total
+}
+
+// Percent format the progress as percentage
+func (m *Meter) Percent() string {
+	present, total := m.Progress()
+	if total <= 0 {
+		return "0%"
+	}
+	return fmt.Sprintf("%d%%", int(100*(float32(present)/float32(total))))
+}
+
+// Rate format the transfer rate, such as "3.17 MB/s"
+func (m *Meter) Rate() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calcRate()
+	a, b, c := split(int64(m.rate))
+	return fmt.Sprintf("%d.%02d %s/s", int(a), int(math.Round(b*100)), c)
+}
+
+// Elapsed format the time elapsed since the transfer started
+func (m *Meter) Elapsed() string {
+	return formatDuration(time.Since(m.start))
+}
+
+// ETA format the estimated remaining time according to the current transfer rate
+func (m *Meter) ETA() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.present >= m.total && m.total > 0 {
+		return formatDuration(0)
+	}
+	if m.rate <= 0 {
+		return "--h:--m:--s"
+	}
+	return formatDuration(time.Duration(float64(m.total-m.present) / m.rate * float64(time.Second)))
+}
+
+func formatDuration(d time.Duration) string {
+	t := int(d.Seconds())
+	hour := t / 60 / 60 % 60
+	minute := t / 60 % 60
+	second := t % 60
+	return fmt.Sprintf("%02dh:%02dm:%02ds", hour, minute, second)
+}
+
+// split the size into integer part, fractional part and unit
+func split(size int64) (a float64, b float64, c string) {
+	v := float64(size)
+	f := 1024.0
+	t := 0
+	for v >= f {
+		v /= f
+		if t >= 3 {
+			break
+		}
+		t++
+	}
+
+	switch t {
+	case 0:
+		c = "B"
+	case 1:
+		c = "KB"
+	case 2:
+		c = "MB"
+	default:
+		c = "GB"
+	}
+	a, b = math.Modf(v)
+	return
+}
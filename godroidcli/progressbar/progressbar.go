@@ -17,7 +17,6 @@ package progressbar
 import (
 	"fmt"
 	"io"
-	"math"
 	"path/filepath"
 	"strings"
 	"time"
@@ -32,30 +31,30 @@ var waitForTime = 65 * time.Millisecond
 type ProgressBar struct {
 	filename    string
 	totalSize   string
-	delta       int64
-	prePresent  int64
-	rate        string
-	start       time.Time
-	lastTime    time.Time
+	meter       *Meter
 	lastUpdated time.Time
+	hideSave    bool
 }
 
 func New(filename string) *ProgressBar {
 	return &ProgressBar{
 		filename:    filename,
-		rate:        "0.00 B/s",
-		start:       time.Now(),
-		lastTime:    time.Now(),
+		meter:       NewMeter(),
 		lastUpdated: time.Now(),
 	}
 }
 
+// HideSavePath do not print the saved file path when the progressbar completed
+func (pb *ProgressBar) HideSavePath() *ProgressBar {
+	pb.hideSave = true
+	return pb
+}
+
 func (pb *ProgressBar) Update(present, total int64) {
 	if present <= 0 || total <= 0 {
 		return
 	}
-	pb.delta += present - pb.prePresent
-	pb.prePresent = present
+	pb.meter.Update(present, total)
 
 	// delay
 	if present < total && time.Since(pb.lastUpdated).Nanoseconds() < waitForTime.Nanoseconds() {
@@ -73,8 +72,8 @@ func (pb *ProgressBar) Update(present, total int64) {
 		util.HiGreen(pb.filename),
 		util.HiYellow(pb.totalSize),
 		pb.calcTransferProgress(present, total),
-		pb.calcProgressPercent(present, total),
-		util.HiRed(pb.calcTransferRate(present, total)),
+		pb.meter.Percent(),
+		util.HiRed(pb.calcTransferRate()),
 		pb.calcTransferTime(),
 	)
 	io.WriteString(util.StdOutput, s)
@@ -92,59 +91,21 @@ func (pb *ProgressBar) isFinish(present, total int64) bool {
 
 func (pb *ProgressBar) finish() {
 	io.WriteString(util.StdOutput, "\n")
+	if pb.hideSave {
+		return
+	}
 	absPath, err := filepath.Abs(pb.filename)
 	if err == nil {
 		util.Info(fmt.Sprintf("save to file: %s", util.HiRed(absPath)))
 	}
 }
 
-func (pb *ProgressBar) calcProgressPercent(present, total int64) string {
-	return fmt.Sprintf("%d%%", int(100*(float32(present)/float32(total))))
-}
-
-func (pb *ProgressBar) split(size int64) (a float64, b float64, c string) {
-	v := float64(size)
-	f := 1024.0
-	t := 0
-	for v >= f {
-		v /= f
-		if t >= 3 {
-			break
-		}
-		t++
-	}
-
-	switch t {
-	case 0:
-		c = "B"
-	case 1:
-		c = "KB"
-	case 2:
-		c = "MB"
-	default:
-		c = "GB"
-	}
-	a, b = math.Modf(v)
-	return
-}
-
-func (pb *ProgressBar) calcTransferRate(present, total int64) string {
-	now := time.Now()
-	if int(now.Sub(pb.lastTime).Seconds()) >= 1 {
-		a, b, c := pb.split(pb.delta)
-		pb.lastTime = now
-		pb.rate = fmt.Sprintf("%d.%02d %s/s", int(a), int(math.Round(b*100)), c)
-		pb.delta = 0
-	}
-	return pb.rate
+func (pb *ProgressBar) calcTransferRate() string {
+	return pb.meter.Rate()
 }
 
 func (pb *ProgressBar) calcTransferTime() string {
-	t := int(time.Since(pb.start).Seconds())
-	hour := t / 60 / 60 % 60
-	minute := t / 60 % 60
-	second := t % 60
-	return fmt.Sprintf("%02dh:%02dm:%02ds", hour, minute, second)
+	return pb.meter.Elapsed()
 }
 
 func (pb *ProgressBar) calcTransferProgress(present, total int64) string {
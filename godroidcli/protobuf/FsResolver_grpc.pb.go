@@ -20,7 +20,7 @@ const _ = grpc.SupportPackageIsVersion7
 type FsResolverClient interface {
 	GetBaseFileTree(ctx context.Context, in *StringTuple, opts ...grpc.CallOption) (*String, error)
 	UploadGeneralFile(ctx context.Context, opts ...grpc.CallOption) (FsResolver_UploadGeneralFileClient, error)
-	DownloadGeneralFile(ctx context.Context, in *String, opts ...grpc.CallOption) (FsResolver_DownloadGeneralFileClient, error)
+	DownloadGeneralFile(ctx context.Context, in *FileRange, opts ...grpc.CallOption) (FsResolver_DownloadGeneralFileClient, error)
 	ListDir(ctx context.Context, in *StringPair, opts ...grpc.CallOption) (*FileInfoList, error)
 	DeleteFile(ctx context.Context, in *String, opts ...grpc.CallOption) (*Status, error)
 	CreateFile(ctx context.Context, in *String, opts ...grpc.CallOption) (*Status, error)
@@ -32,6 +32,8 @@ type FsResolverClient interface {
 	ReadText(ctx context.Context, in *String, opts ...grpc.CallOption) (*Status, error)
 	WriteText(ctx context.Context, in *StringPair, opts ...grpc.CallOption) (*Status, error)
 	AppendText(ctx context.Context, in *StringPair, opts ...grpc.CallOption) (*Status, error)
+	SetLastModified(ctx context.Context, in *StringLong, opts ...grpc.CallOption) (*Status, error)
+	GetFileHash(ctx context.Context, in *StringPair, opts ...grpc.CallOption) (*String, error)
 }
 
 type fsResolverClient struct {
@@ -85,7 +87,7 @@ func (x *fsResolverUploadGeneralFileClient) CloseAndRecv() (*Status, error) {
 	return m, nil
 }
 
-func (c *fsResolverClient) DownloadGeneralFile(ctx context.Context, in *String, opts ...grpc.CallOption) (FsResolver_DownloadGeneralFileClient, error) {
+func (c *fsResolverClient) DownloadGeneralFile(ctx context.Context, in *FileRange, opts ...grpc.CallOption) (FsResolver_DownloadGeneralFileClient, error) {
 	stream, err := c.cc.NewStream(ctx, &FsResolver_ServiceDesc.Streams[1], "/protobuf.FsResolver/DownloadGeneralFile", opts...)
 	if err != nil {
 		return nil, err
@@ -216,13 +218,31 @@ func (c *fsResolverClient) AppendText(ctx context.Context, in *StringPair, opts
 	return out, nil
 }
 
+func (c *fsResolverClient) SetLastModified(ctx context.Context, in *StringLong, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/protobuf.FsResolver/SetLastModified", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fsResolverClient) GetFileHash(ctx context.Context, in *StringPair, opts ...grpc.CallOption) (*String, error) {
+	out := new(String)
+	err := c.cc.Invoke(ctx, "/protobuf.FsResolver/GetFileHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FsResolverServer is the server API for FsResolver service.
 // All implementations must embed UnimplementedFsResolverServer
 // for forward compatibility
 type FsResolverServer interface {
 	GetBaseFileTree(context.Context, *StringTuple) (*String, error)
 	UploadGeneralFile(FsResolver_UploadGeneralFileServer) error
-	DownloadGeneralFile(*String, FsResolver_DownloadGeneralFileServer) error
+	DownloadGeneralFile(*FileRange, FsResolver_DownloadGeneralFileServer) error
 	ListDir(context.Context, *StringPair) (*FileInfoList, error)
 	DeleteFile(context.Context, *String) (*Status, error)
 	CreateFile(context.Context, *String) (*Status, error)
@@ -234,6 +254,8 @@ type FsResolverServer interface {
 	ReadText(context.Context, *String) (*Status, error)
 	WriteText(context.Context, *StringPair) (*Status, error)
 	AppendText(context.Context, *StringPair) (*Status, error)
+	SetLastModified(context.Context, *StringLong) (*Status, error)
+	GetFileHash(context.Context, *StringPair) (*String, error)
 	mustEmbedUnimplementedFsResolverServer()
 }
 
@@ -247,7 +269,7 @@ func (UnimplementedFsResolverServer) GetBaseFileTree(context.Context, *StringTup
 func (UnimplementedFsResolverServer) UploadGeneralFile(FsResolver_UploadGeneralFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadGeneralFile not implemented")
 }
-func (UnimplementedFsResolverServer) DownloadGeneralFile(*String, FsResolver_DownloadGeneralFileServer) error {
+func (UnimplementedFsResolverServer) DownloadGeneralFile(*FileRange, FsResolver_DownloadGeneralFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadGeneralFile not implemented")
 }
 func (UnimplementedFsResolverServer) ListDir(context.Context, *StringPair) (*FileInfoList, error) {
@@ -283,6 +305,12 @@ func (UnimplementedFsResolverServer) WriteText(context.Context, *StringPair) (*S
 func (UnimplementedFsResolverServer) AppendText(context.Context, *StringPair) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendText not implemented")
 }
+func (UnimplementedFsResolverServer) SetLastModified(context.Context, *StringLong) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLastModified not implemented")
+}
+func (UnimplementedFsResolverServer) GetFileHash(context.Context, *StringPair) (*String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileHash not implemented")
+}
 func (UnimplementedFsResolverServer) mustEmbedUnimplementedFsResolverServer() {}
 
 // UnsafeFsResolverServer may be embedded to opt out of forward compatibility for this service.
@@ -341,7 +369,7 @@ func (x *fsResolverUploadGeneralFileServer) Recv() (*ParamBytes, error) {
 }
 
 func _FsResolver_DownloadGeneralFile_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(String)
+	m := new(FileRange)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -559,6 +587,42 @@ func _FsResolver_AppendText_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FsResolver_SetLastModified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringLong)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsResolverServer).SetLastModified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.FsResolver/SetLastModified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsResolverServer).SetLastModified(ctx, req.(*StringLong))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FsResolver_GetFileHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StringPair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FsResolverServer).GetFileHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/protobuf.FsResolver/GetFileHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FsResolverServer).GetFileHash(ctx, req.(*StringPair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FsResolver_ServiceDesc is the grpc.ServiceDesc for FsResolver service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -614,6 +678,14 @@ var FsResolver_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AppendText",
 			Handler:    _FsResolver_AppendText_Handler,
 		},
+		{
+			MethodName: "SetLastModified",
+			Handler:    _FsResolver_SetLastModified_Handler,
+		},
+		{
+			MethodName: "GetFileHash",
+			Handler:    _FsResolver_GetFileHash_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -34,6 +34,18 @@ var (
 	ErrUnmarshalToJson      = errors.New("could not unmarshal bytes to json object")
 	ErrMarshalProtoToJSON   = errors.New("could not marshal proto message to json object")
 	ErrPathEmpty            = errors.New("path cannot be empty")
+	ErrCommandFailed        = errors.New("command execution failed")
+	ErrFingerprintMismatch  = errors.New("server certificate fingerprint mismatch")
+	ErrAuthFailed           = errors.New("authentication failed, please check the token")
+	ErrPlaintextToken       = errors.New("refuse to send the token without TLS over network, enable TLS or allow it with --insecure-token")
+	ErrMountNotSupported    = errors.New("mounting the file system is only supported on Linux")
+	ErrHashMismatch         = errors.New("the digest of local file and remote file mismatch")
+	ErrRemoteModified       = errors.New("the remote file has been modified since it was downloaded")
+	ErrTrashNotFound        = errors.New("no such entry in the trash")
+	ErrNothingToUndo        = errors.New("there is no rename or move to undo")
+	ErrPathExists           = errors.New("the path already exists")
+	ErrArchiveFormat        = errors.New("unsupported archive format, only tar.gz and zip are supported")
+	ErrAborted              = errors.New("aborted, use --yes to skip the confirmation")
 )
 
 var (
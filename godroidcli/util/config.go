@@ -21,10 +21,62 @@ import (
 	"sync"
 )
 
+const (
+	ConfigFile     = "config.json"
+	KnownHostsFile = "known_hosts"
+)
+
+// TLSConfig the TLS settings of rpc connection
+type TLSConfig struct {
+	// use TLS for all sessions
+	Enable bool `json:"enable"`
+	// the server certificate is verified by the pinned CA certificate,
+	// otherwise it is trusted on first use and verified by the fingerprint store
+	CAFile     string `json:"ca_file,omitempty"`
+	ServerName string `json:"server_name,omitempty"`
+	// client certificate and private key for mutual TLS
+	CertFile string `json:"cert_file,omitempty"`
+	KeyFile  string `json:"key_file,omitempty"`
+	// the fingerprint store for trust-on-first-use
+	KnownHostsFile string `json:"known_hosts_file,omitempty"`
+}
+
+// Profile the saved connection settings of device
+type Profile struct {
+	// connect to device by serial number via adb, or by ip address
+	Serial    string `json:"serial,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Port      int    `json:"port"`
+	TLS       bool   `json:"tls,omitempty"`
+	Token     string `json:"token,omitempty"`
+	RemoteDir string `json:"remote_dir,omitempty"`
+	// allow sending the token without TLS over network
+	InsecureToken bool `json:"insecure_token,omitempty"`
+}
+
 type Config struct {
 	AdbPath     string `json:"adb_path"`
 	HistoryFile string `json:"history_file"`
 	Address     string `json:"address"`
+	// alias name -> command lines, the positional parameters $1, $2 ... can be used in command lines
+	Aliases map[string][]string `json:"aliases,omitempty"`
+	TLS     *TLSConfig          `json:"tls,omitempty"`
+	// the default pre-shared token and the tokens of devices (serial number or ip:port)
+	Token  string            `json:"token,omitempty"`
+	Tokens map[string]string `json:"tokens,omitempty"`
+	// allow sending the token without TLS over network
+	InsecureToken bool `json:"insecure_token,omitempty"`
+	// profile name -> connection settings
+	Profiles map[string]*Profile `json:"profiles,omitempty"`
+	// the remote directory shared through WebDAV
+	DavRoot string `json:"dav_root,omitempty"`
+	// the max number of background transfers running concurrently
+	MaxJobs int `json:"max_jobs,omitempty"`
+	// the initial size of the chunks uploaded to device
+	ChunkSize int `json:"chunk_size,omitempty"`
+	// the deleted paths are moved into the trash directory on device in safe mode
+	SafeMode bool   `json:"safe_mode,omitempty"`
+	TrashDir string `json:"trash_dir,omitempty"`
 }
 
 var (
@@ -37,7 +89,7 @@ func GetConfig() *Config {
 		// singleton
 		once.Do(func() {
 			config = new(Config)
-			fp, err := os.Open("config.json")
+			fp, err := os.Open(ConfigFile)
 			if err != nil {
 				ErrorBy(err)
 			}
@@ -51,3 +103,12 @@ func GetConfig() *Config {
 	}
 	return config
 }
+
+// SaveConfig write the current configuration back to the configuration file
+func SaveConfig() error {
+	data, err := json.MarshalIndent(GetConfig(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(ConfigFile, data, 0644)
+}
@@ -15,14 +15,27 @@
 package util
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
 
 	"github.com/fatih/color"
 )
 
 var (
 	StdOutput = color.Output
+	// the log messages are written to stderr in non-interactive mode,
+	// so that the output of commands can be piped to other programs
+	LogOutput = color.Output
+	// the number of error messages printed so far
+	errorCount int64
+	// the goroutines whose error messages are not counted, and the number of them
+	uncounted  sync.Map
+	uncountedN int32
 )
 
 var Logo = `
@@ -51,12 +64,58 @@ func Print(format string, v ...interface{}) {
 }
 
 func Printf(level Level, format string, v ...interface{}) {
-	fmt.Fprintf(StdOutput, "%s %s\n", typeOf(level), fmt.Sprintf(format, v...))
+	fmt.Fprintf(LogOutput, "%s %s\n", typeOf(level), fmt.Sprintf(format, v...))
+	if level >= ERROR && !isUncounted() {
+		atomic.AddInt64(&errorCount, 1)
+	}
 	if level == FATAL {
-		os.Exit(0)
+		os.Exit(1)
 	}
 }
 
+// LogToStderr write the log messages to stderr
+func LogToStderr() {
+	LogOutput = color.Error
+}
+
+// ErrorCount return the number of error messages printed so far,
+// it is used to check whether a command failed
+func ErrorCount() int64 {
+	return atomic.LoadInt64(&errorCount)
+}
+
+// goroutineID parse the id of current goroutine from the header of its stack, such as "goroutine 18 [running]:"
+func goroutineID() uint64 {
+	var buf [64]byte
+	fields := bytes.Fields(buf[:runtime.Stack(buf[:], false)])
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseUint(string(fields[1]), 10, 64)
+	return id
+}
+
+func isUncounted() bool {
+	if atomic.LoadInt32(&uncountedN) == 0 {
+		return false
+	}
+	_, ok := uncounted.Load(goroutineID())
+	return ok
+}
+
+// Uncounted run fn in current goroutine without counting its error messages by ErrorCount,
+// so that the errors of background jobs do not fail the command in foreground
+func Uncounted(fn func()) {
+	id := goroutineID()
+	uncounted.Store(id, struct{}{})
+	atomic.AddInt32(&uncountedN, 1)
+	defer func() {
+		atomic.AddInt32(&uncountedN, -1)
+		uncounted.Delete(id)
+	}()
+	fn()
+}
+
 func Info(format string, v ...interface{}) {
 	Printf(INFO, format, v...)
 }
@@ -0,0 +1,49 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package util
+
+import (
+	"io"
+	"testing"
+)
+
+func TestUncounted(t *testing.T) {
+	output := LogOutput
+	LogOutput = io.Discard
+	defer func() { LogOutput = output }()
+
+	count := ErrorCount()
+	done := make(chan struct{})
+	go Uncounted(func() {
+		defer close(done)
+		Error("background")
+	})
+	<-done
+	if ErrorCount() != count {
+		t.Fatalf("the error of uncounted goroutine is counted")
+	}
+	Uncounted(func() {
+		// the other goroutines are still counted
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			Error("foreground")
+		}()
+		<-done
+	})
+	if ErrorCount() != count+1 {
+		t.Fatalf("got %d errors, want %d", ErrorCount()-count, 1)
+	}
+}
@@ -23,7 +23,9 @@ import java.nio.channels.Channels;
 import java.nio.channels.ReadableByteChannel;
 
 public class DownloadStreamHandler {
-    private final static int BUFFER_SIZE = 4096;
+    // the chunk size starts small and grows while the source keeps filling it
+    private final static int MIN_BUFFER_SIZE = 16 * 1024;
+    private final static int MAX_BUFFER_SIZE = 256 * 1024;
     private final InputStream in;
     private final ByteBuffer buffer;
     private final ReadableByteChannel channel;
@@ -31,11 +33,13 @@ public class DownloadStreamHandler {
     private ByteString preSendData;
     private boolean preDataSent = false;
     private int sendState = 0;
+    private long limit = -1;
+    private int chunkSize = MIN_BUFFER_SIZE;
 
     public DownloadStreamHandler(InputStream in) {
         this.in = in;
         this.channel = Channels.newChannel(in);
-        this.buffer = ByteBuffer.allocate(BUFFER_SIZE);
+        this.buffer = ByteBuffer.allocate(MAX_BUFFER_SIZE);
     }
 
     /**
@@ -52,6 +56,15 @@ public class DownloadStreamHandler {
         preSendData = ByteString.copyFrom(result);
     }
 
+    /**
+     * limit the size of bytes stream, negative means no limit
+     *
+     * @param n
+     */
+    public void setLimit(long n) {
+        limit = n;
+    }
+
     private void sendMask(StreamCallback callback) {
         byte mask;
         if (!preDataSent && preSendData != null) {
@@ -81,6 +94,14 @@ public class DownloadStreamHandler {
                         sendState = 2;
                         break;
                     case 2: // main bytes stream
+                        if (limit == 0) {
+                            break completed;
+                        }
+                        int size = chunkSize;
+                        if (limit > 0 && limit < size) {
+                            size = (int) limit;
+                        }
+                        buffer.limit(size);
                         int n = channel.read(buffer);
                         if (n <= 0) {
                             break completed;
@@ -88,6 +109,12 @@ public class DownloadStreamHandler {
                         buffer.flip();
                         callback.write(ByteString.copyFrom(buffer));
                         buffer.clear();
+                        if (limit > 0) {
+                            limit -= n;
+                        }
+                        if (n == chunkSize && chunkSize < MAX_BUFFER_SIZE) {
+                            chunkSize <<= 1;
+                        }
                         break;
                     default:
                         break completed;
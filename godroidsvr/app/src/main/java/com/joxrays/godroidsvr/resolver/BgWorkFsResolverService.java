@@ -16,10 +16,13 @@ package com.joxrays.godroidsvr.resolver;
 
 import android.util.Pair;
 
+import com.google.protobuf.ByteString;
 import com.joxrays.godroidsvr.message.Bytes;
 import com.joxrays.godroidsvr.message.FileInfoList;
+import com.joxrays.godroidsvr.message.FileRange;
 import com.joxrays.godroidsvr.message.ParamBytes;
 import com.joxrays.godroidsvr.message.Status;
+import com.joxrays.godroidsvr.message.StringLong;
 import com.joxrays.godroidsvr.message.StringPair;
 import com.joxrays.godroidsvr.message.StringTuple;
 import com.joxrays.godroidsvr.observer.DownloadStreamHandler;
@@ -30,9 +33,10 @@ import com.joxrays.godroidsvr.util.FilesUtil;
 
 import java.io.File;
 import java.io.FileInputStream;
-import java.io.InputStream;
+import java.io.RandomAccessFile;
 import java.nio.file.Path;
 
+import io.grpc.stub.ServerCallStreamObserver;
 import io.grpc.stub.StreamObserver;
 
 public class BgWorkFsResolverService extends FsResolverGrpc.FsResolverImplBase {
@@ -50,6 +54,9 @@ public class BgWorkFsResolverService extends FsResolverGrpc.FsResolverImplBase {
         AppendText,
     }
 
+    // the interval of checking whether the followed file grows
+    private static final long FOLLOW_INTERVAL = 500;
+
     private final BgWorkBaseResolverGroup group;
 
     public BgWorkFsResolverService(BgWorkBaseResolverGroup group) {
@@ -90,20 +97,32 @@ public class BgWorkFsResolverService extends FsResolverGrpc.FsResolverImplBase {
     }
 
     @Override
-    public void downloadGeneralFile(String request, StreamObserver<Bytes> responseObserver) {
+    public void downloadGeneralFile(FileRange request, StreamObserver<Bytes> responseObserver) {
         File file = new File(request.getValue());
         if (!file.exists()) {
             responseObserver.onError(ErrorExceptionUtil.getRpcException(ErrorExceptionUtil.ErrorFileNotFound));
         } else {
-            InputStream in;
+            long offset = Math.max(request.getOffset(), 0);
+            if (offset > file.length()) {
+                responseObserver.onError(ErrorExceptionUtil.getRpcException(ErrorExceptionUtil.ErrorOperandFailed));
+                return;
+            }
+            // the size of bytes stream to be sent from offset
+            long size = file.length() - offset;
+            if (request.getLength() > 0) {
+                size = Math.min(size, request.getLength());
+            }
+            FileInputStream in;
             try {
                 in = new FileInputStream(file);
+                in.getChannel().position(offset);
             } catch (Exception ex) {
                 responseObserver.onError(ErrorExceptionUtil.getRpcException(ex));
                 return;
             }
             DownloadStreamHandler handler = new DownloadStreamHandler(in);
-            handler.sendLongPreData(file.length());
+            handler.sendLongPreData(size);
+            handler.setLimit(size);
             Exception ex = handler.handle(bytes -> {
                 responseObserver.onNext(Bytes.newBuilder().setValue(bytes).build());
             });
@@ -111,10 +130,54 @@ public class BgWorkFsResolverService extends FsResolverGrpc.FsResolverImplBase {
                 responseObserver.onError(ErrorExceptionUtil.getRpcException(ex));
                 return;
             }
+            if (request.getFollow()) {
+                followFile(file, offset + size, responseObserver);
+                return;
+            }
             responseObserver.onCompleted();
         }
     }
 
+    /**
+     * keep sending the bytes appended to file until the call is canceled,
+     * the file is read from the beginning again if it is truncated
+     *
+     * @param file
+     * @param position
+     * @param responseObserver
+     */
+    private void followFile(File file, long position, StreamObserver<Bytes> responseObserver) {
+        ServerCallStreamObserver<Bytes> observer = (ServerCallStreamObserver<Bytes>) responseObserver;
+        byte[] buffer = new byte[4096];
+        try {
+            while (!observer.isCancelled()) {
+                long length = file.length();
+                if (length < position) {
+                    position = 0;
+                }
+                if (length == position) {
+                    Thread.sleep(FOLLOW_INTERVAL);
+                    continue;
+                }
+                try (RandomAccessFile in = new RandomAccessFile(file, "r")) {
+                    in.seek(position);
+                    while (position < length) {
+                        int n = in.read(buffer, 0, (int) Math.min(buffer.length, length - position));
+                        if (n <= 0) {
+                            break;
+                        }
+                        responseObserver.onNext(Bytes.newBuilder().setValue(ByteString.copyFrom(buffer, 0, n)).build());
+                        position += n;
+                    }
+                }
+            }
+        } catch (Exception ex) {
+            if (!observer.isCancelled()) {
+                responseObserver.onError(ErrorExceptionUtil.getRpcException(ex));
+            }
+        }
+    }
+
     private void handleFileAndDirOp(OperandType type, java.lang.String first, java.lang.String second,
                                     StreamObserver<Status> responseObserver) {
         File file = new File(first);
@@ -234,6 +297,35 @@ public class BgWorkFsResolverService extends FsResolverGrpc.FsResolverImplBase {
     public void appendText(StringPair request, StreamObserver<Status> responseObserver) {
         handleFileAndDirOp(OperandType.AppendText, request.getFirst(), request.getSecond(), responseObserver);
     }
+
+    @Override
+    public void getFileHash(StringPair request, StreamObserver<String> responseObserver) {
+        File file = new File(request.getFirst());
+        if (!file.isFile()) {
+            responseObserver.onError(ErrorExceptionUtil.getRpcException(ErrorExceptionUtil.ErrorFileNotFound));
+            return;
+        }
+        Pair<java.lang.String, Exception> pair = FilesUtil.hashFile(file, request.getSecond());
+        if (pair.second != null) {
+            responseObserver.onError(ErrorExceptionUtil.getRpcException(pair.second));
+            return;
+        }
+        responseObserver.onNext(String.newBuilder().setValue(pair.first).build());
+        responseObserver.onCompleted();
+    }
+
+    @Override
+    public void setLastModified(StringLong request, StreamObserver<Status> responseObserver) {
+        File file = new File(request.getFirst());
+        if (!file.setLastModified(request.getSecond())) {
+            responseObserver.onError(ErrorExceptionUtil.getRpcException(ErrorExceptionUtil.ErrorOperandFailed));
+            return;
+        }
+        responseObserver.onNext(Status.newBuilder()
+                .setStatus(Status.CODE.SUCCEED)
+                .build());
+        responseObserver.onCompleted();
+    }
 }
 
 
@@ -35,14 +35,30 @@ import com.joxrays.godroidsvr.resolver.BgWorkSmsResolverService;
 import com.joxrays.godroidsvr.util.LogUtil;
 import com.joxrays.godroidsvr.resolver.BgWorkPmResolverService;
 
+import java.io.File;
+import java.io.IOException;
+import java.nio.charset.StandardCharsets;
+import java.nio.file.Files;
 import java.util.concurrent.TimeUnit;
 
+import javax.net.ssl.SSLException;
+
 import io.grpc.Server;
+import io.grpc.netty.shaded.io.grpc.netty.GrpcSslContexts;
 import io.grpc.netty.shaded.io.grpc.netty.NettyServerBuilder;
 import io.grpc.netty.shaded.io.netty.channel.ChannelOption;
+import io.grpc.netty.shaded.io.netty.handler.ssl.ClientAuth;
+import io.grpc.netty.shaded.io.netty.handler.ssl.SslContext;
+import io.grpc.netty.shaded.io.netty.handler.ssl.SslContextBuilder;
 
 public class RpcServerWorker extends Worker {
     public final static String TAG = "RpcServerWorker";
+    // put these files into the files directory of application to enable TLS
+    public final static String TLS_CERT_FILE = "server.crt";
+    public final static String TLS_KEY_FILE = "server.key";
+    public final static String TLS_CLIENT_CA_FILE = "client_ca.crt";
+    // the pre-shared token is required if this file exists
+    public final static String TOKEN_FILE = "token";
     private Server server;
     private final int rpcPort;
     private boolean isRunning;
@@ -70,11 +86,20 @@ public class RpcServerWorker extends Worker {
 
         LogUtil.d("start server on: " + port);
 
-        server = NettyServerBuilder.forPort(port)
+        NettyServerBuilder builder = NettyServerBuilder.forPort(port)
                 .addServices(workBaseResolverGroup.getServices())
-                .withChildOption(ChannelOption.SO_REUSEADDR, true)
-                .build();
+                .withChildOption(ChannelOption.SO_REUSEADDR, true);
         try {
+            SslContext sslContext = buildSslContext();
+            if (sslContext != null) {
+                builder.sslContext(sslContext);
+            }
+            String token = readToken();
+            if (token != null) {
+                LogUtil.d("enable token authentication for rpc server");
+                builder.intercept(new TokenAuthInterceptor(token));
+            }
+            server = builder.build();
             server.start();
             server.awaitTermination();
             return null;
@@ -83,6 +108,33 @@ public class RpcServerWorker extends Worker {
         }
     }
 
+    // TLS is enabled if the server certificate and private key exist,
+    // and the client certificate is required if the client CA certificate exists
+    private SslContext buildSslContext() throws SSLException {
+        File dir = getApplicationContext().getFilesDir();
+        File cert = new File(dir, TLS_CERT_FILE);
+        File key = new File(dir, TLS_KEY_FILE);
+        if (!cert.exists() || !key.exists()) {
+            return null;
+        }
+        SslContextBuilder builder = GrpcSslContexts.forServer(cert, key);
+        File clientCa = new File(dir, TLS_CLIENT_CA_FILE);
+        if (clientCa.exists()) {
+            builder.trustManager(clientCa).clientAuth(ClientAuth.REQUIRE);
+        }
+        LogUtil.d("enable TLS for rpc server");
+        return builder.build();
+    }
+
+    private String readToken() throws IOException {
+        File file = new File(getApplicationContext().getFilesDir(), TOKEN_FILE);
+        if (!file.exists()) {
+            return null;
+        }
+        String token = new String(Files.readAllBytes(file.toPath()), StandardCharsets.UTF_8).trim();
+        return token.isEmpty() ? null : token;
+    }
+
     public void stopServer() {
         if (server != null) {
             try {
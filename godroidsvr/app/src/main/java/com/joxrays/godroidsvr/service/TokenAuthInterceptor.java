@@ -0,0 +1,48 @@
+// Copyright [2021] [josexy]
+//
+// Licensed under the Apache License, Version 2.0 (the "License"): you may
+// not use this file except in compliance with the License. You may obtain
+// a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package com.joxrays.godroidsvr.service;
+
+import java.nio.charset.StandardCharsets;
+import java.security.MessageDigest;
+
+import io.grpc.Metadata;
+import io.grpc.ServerCall;
+import io.grpc.ServerCallHandler;
+import io.grpc.ServerInterceptor;
+import io.grpc.Status;
+
+// reject all rpc calls without the pre-shared token "authorization: Bearer TOKEN"
+public class TokenAuthInterceptor implements ServerInterceptor {
+    private static final Metadata.Key<String> AUTHORIZATION =
+            Metadata.Key.of("authorization", Metadata.ASCII_STRING_MARSHALLER);
+    private static final String SCHEME = "Bearer ";
+    private final byte[] expected;
+
+    public TokenAuthInterceptor(String token) {
+        this.expected = (SCHEME + token).getBytes(StandardCharsets.UTF_8);
+    }
+
+    @Override
+    public <ReqT, RespT> ServerCall.Listener<ReqT> interceptCall(ServerCall<ReqT, RespT> call, Metadata headers, ServerCallHandler<ReqT, RespT> next) {
+        String value = headers.get(AUTHORIZATION);
+        // compare in constant time, so that the token can not be guessed by timing
+        if (value == null || !MessageDigest.isEqual(value.getBytes(StandardCharsets.UTF_8), expected)) {
+            call.close(Status.UNAUTHENTICATED.withDescription("invalid token"), new Metadata());
+            return new ServerCall.Listener<ReqT>() {
+            };
+        }
+        return next.startCall(call, headers);
+    }
+}
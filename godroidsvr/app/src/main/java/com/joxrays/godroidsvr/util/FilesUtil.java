@@ -22,7 +22,9 @@ import com.joxrays.godroidsvr.message.FileInfo;
 import com.joxrays.godroidsvr.message.FileInfoList;
 
 import java.io.File;
+import java.io.FileInputStream;
 import java.io.IOException;
+import java.io.InputStream;
 import java.nio.charset.StandardCharsets;
 import java.nio.file.CopyOption;
 import java.nio.file.Files;
@@ -30,6 +32,7 @@ import java.nio.file.Path;
 import java.nio.file.Paths;
 import java.nio.file.StandardCopyOption;
 import java.nio.file.StandardOpenOption;
+import java.security.MessageDigest;
 import java.util.ArrayList;
 import java.util.List;
 
@@ -233,6 +236,31 @@ public final class FilesUtil {
         }
     }
 
+    /**
+     * calculate the hex digest of file
+     *
+     * @param file
+     * @param algorithm sha256 or md5
+     * @return
+     */
+    public static Pair<String, Exception> hashFile(File file, String algorithm) {
+        try (InputStream in = new FileInputStream(file)) {
+            MessageDigest digest = MessageDigest.getInstance(algorithm.equalsIgnoreCase("md5") ? "MD5" : "SHA-256");
+            byte[] buffer = new byte[8192];
+            int n;
+            while ((n = in.read(buffer)) > 0) {
+                digest.update(buffer, 0, n);
+            }
+            StringBuilder sb = new StringBuilder();
+            for (byte b : digest.digest()) {
+                sb.append(String.format("%02x", b));
+            }
+            return Pair.create(sb.toString(), null);
+        } catch (Exception ex) {
+            return Pair.create("", ex);
+        }
+    }
+
     public static Pair<Path, Exception> writeText(File file, String text, boolean append) {
         try {
             Path path = file.toPath();